@@ -0,0 +1,35 @@
+package api
+
+import (
+	"errors"
+	"gitbeam/utils"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+func (a API) newAdminRoute() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/reconcile-status", a.getReconcileStatus)
+
+	return router
+}
+
+// getReconcileStatus reports the last reconciliation of the monitoring config file, and how the commit
+// monitor drifted from it since.
+func (a API) getReconcileStatus(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getReconcileStatus").Logger
+	if a.reconciler == nil {
+		utils.WriteHTTPError(w, http.StatusNotFound, errors.New("no monitoring config is reconciled, set MONITORING_CONFIG_PATH to enable it"))
+		return
+	}
+
+	status, err := a.reconciler.Status(r.Context())
+	if err != nil {
+		useLogger.WithError(err).Error("failed to measure drift from the monitoring config")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved reconcile status", status)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"gitbeam/api/pb/commits"
 	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/manifest"
 	"github.com/go-chi/chi/v5"
 	"github.com/sirupsen/logrus"
 )
@@ -11,6 +12,7 @@ type API struct {
 	commitsRPC commits.GitBeamCommitsServiceClient
 	reposRPC   gitRepos.GitBeamRepositoryServiceClient
 	logger     *logrus.Logger
+	reconciler *manifest.Reconciler
 }
 
 func New(commitsRPC commits.GitBeamCommitsServiceClient, reposRPC gitRepos.GitBeamRepositoryServiceClient, logger *logrus.Logger) *API {
@@ -21,8 +23,20 @@ func New(commitsRPC commits.GitBeamCommitsServiceClient, reposRPC gitRepos.GitBe
 	}
 }
 
+// WithReconciler exposes the status of the reconciler under /admin.
+func (a *API) WithReconciler(reconciler *manifest.Reconciler) *API {
+	a.reconciler = reconciler
+	return a
+}
+
 func (a API) Routes(router *chi.Mux) {
 	// Mount all route paths here.
 	router.Mount("/repos", a.newReposRoute())
 	router.Mount("/commits", a.newCommitsRoute())
+	router.Mount("/authors", a.newAuthorsRoute())
+	router.Mount("/orgs", a.newOrgsRoute())
+	router.Mount("/issues", a.newIssuesRoute())
+	router.Mount("/admin", a.newAdminRoute())
+	router.Get("/openapi.json", a.getOpenAPISpec(router))
+	router.Get("/docs", a.getSwaggerUI)
 }
@@ -1,11 +1,15 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"gitbeam/api/pb/commits"
 	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/manifest"
 	"gitbeam/mocks"
 	"gitbeam/models"
 	"github.com/go-chi/chi/v5"
@@ -13,12 +17,42 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
+// newTestRouter mounts the routes on mocked RPC clients, checking every expectation when the test ends.
+func newTestRouter(t *testing.T) (*chi.Mux, *mocks.MockGitBeamCommitsServiceClient, *mocks.MockGitBeamRepositoryServiceClient) {
+	controller := gomock.NewController(t)
+	t.Cleanup(controller.Finish)
+
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	router := chi.NewMux()
+	New(mockCommitsRPC, mockRepoRPC, logrus.New()).Routes(router)
+	return router, mockCommitsRPC, mockRepoRPC
+}
+
+// serve records the response of the router to a request.
+func serve(t *testing.T, router http.Handler, method, target string, body io.Reader) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(method, target, body)
+	assert.Nil(t, err)
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
 func TestListRepositories(t *testing.T) {
 	logger := logrus.New()
 	controller := gomock.NewController(t)
@@ -26,7 +60,7 @@ func TestListRepositories(t *testing.T) {
 
 	ctx := context.Background()
 	repoRPCMock := mocks.NewMockGitBeamRepositoryServiceClient(controller)
-	repoRPCMock.EXPECT().ListGitRepositories(gomock.Any(), &gitRepos.Void{}).MaxTimes(1).Return(
+	repoRPCMock.EXPECT().ListGitRepositories(gomock.Any(), &gitRepos.ListGitRepositoriesRequest{}).MaxTimes(1).Return(
 		&gitRepos.ListGitRepositoriesResponse{
 			Repos: []*gitRepos.Repo{
 				{Name: "chromium"},
@@ -47,6 +81,46 @@ func TestListRepositories(t *testing.T) {
 	assert.Contains(t, rr.Body.String(), "brave")
 }
 
+func TestSearchRepositories(t *testing.T) {
+	router, _, mockRepoRPC := newTestRouter(t)
+
+	monitored := true
+	mockRepoRPC.EXPECT().ListGitRepositories(gomock.Any(), &gitRepos.ListGitRepositoriesRequest{
+		OwnerName: "chromium",
+		Language:  "C++",
+		Name:      "chrom",
+		MinStars:  1000,
+		Monitored: &monitored,
+		SortBy:    models.RepoSortByStars,
+		Order:     models.OrderDesc,
+		Page:      2,
+		Limit:     20,
+	}).Times(1).Return(
+		&gitRepos.ListGitRepositoriesResponse{
+			Repos: []*gitRepos.Repo{
+				{Name: "chromium", Owner: "chromium", Monitored: true},
+			},
+		}, nil)
+
+	query := url.Values{
+		"ownerName": {"chromium"},
+		"language":  {"C++"},
+		"name":      {"chrom"},
+		"minStars":  {"1000"},
+		"monitored": {"true"},
+		"sortBy":    {"stars"},
+		"order":     {"desc"},
+		"page":      {"2"},
+		"limit":     {"20"},
+	}
+	rr := serve(t, router, http.MethodGet, "/repos?"+query.Encode(), nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"monitored":true`)
+
+	rr = serve(t, router, http.MethodGet, "/repos?sortBy=forks", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
 func TestGetRepo(t *testing.T) {
 	logger := logrus.New()
 	controller := gomock.NewController(t)
@@ -133,3 +207,1025 @@ func TestListCommits(t *testing.T) {
 	assert.Contains(t, rr.Body.String(), commit.Author)
 	assert.Contains(t, rr.Body.String(), commit.Sha)
 }
+
+func TestListCommitsByBranch(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListCommits(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Branch:    "release/127",
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01", Branches: []string{"release/127"}},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits?ownerName=chromium&repoName=chromium&branch=release/127", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "release/127")
+}
+
+func TestListRepoTags(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListTags(gomock.Any(), &commits.RepoRefsParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Page:      1,
+		Limit:     10,
+	}).Times(1).Return(
+		&commits.ListTagsResponse{
+			Data: []*commits.Tag{
+				{Name: "128.0.6613.1", CommitSha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/repos/chromium/chromium/tags?page=1&limit=10", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "128.0.6613.1")
+}
+
+func TestListCommitsBetweenTags(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListCommitsBetweenTags(gomock.Any(), &commits.CommitsBetweenTagsParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		FromTag:   "128.0.6613.1",
+		ToTag:     "128.0.6613.2",
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/repos/chromium/chromium/tags/compare?fromTag=128.0.6613.1&toTag=128.0.6613.2", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01")
+
+	rr = serve(t, router, http.MethodGet, "/repos/chromium/chromium/tags/compare?fromTag=128.0.6613.1", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestGetCommitBySha(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	sha := "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"
+	mockCommitsRPC.EXPECT().GetCommitByOwnerAndSHA(gomock.Any(), &commits.CommitByOwnerAndShaParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Sha:       sha,
+	}).Times(1).Return(
+		&commits.Commit{
+			Sha:   sha,
+			Stats: &commits.CommitStats{Additions: 12, Deletions: 3, Total: 15},
+			Files: []*commits.CommitFile{
+				{Filename: "chrome/browser/sync/sync_service_factory.cc", Status: "modified", Additions: 12, Deletions: 3, Changes: 15},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits/chromium/chromium/"+sha, nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "chrome/browser/sync/sync_service_factory.cc")
+	assert.Contains(t, rr.Body.String(), `"additions":12`)
+}
+
+func TestSearchCommits(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().SearchCommits(gomock.Any(), &commits.SearchCommitsParams{
+		Query:     `"Bug: 354474887"`,
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Author:    "Marc Treib",
+		FromDate:  "2024-07-01",
+	}).Times(1).Return(
+		&commits.SearchCommitsResponse{
+			Data: []*commits.CommitSearchResult{
+				{
+					Commit:  &commits.Commit{Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"},
+					Snippet: "<mark>Bug: 354474887</mark>",
+				},
+			},
+		},
+		nil,
+	)
+
+	query := url.Values{}
+	query.Set("q", `"Bug: 354474887"`)
+	query.Set("ownerName", "chromium")
+	query.Set("repoName", "chromium")
+	query.Set("author", "Marc Treib")
+	query.Set("fromDate", "2024-07-01")
+	rr := serve(t, router, http.MethodGet, "/commits/search?"+query.Encode(), nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01")
+
+	rr = serve(t, router, http.MethodGet, "/commits/search?q=", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestListCommitsByTrailer(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListCommits(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Trailers:  map[string]string{"Bug": "354474887"},
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{
+					Sha:      "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+					Trailers: []*commits.Trailer{{Key: "Bug", Value: "354474887"}},
+				},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits?ownerName=chromium&repoName=chromium&trailer.Bug=354474887", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "354474887")
+}
+
+func TestListTopReviewers(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListTopReviewers(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Times(1).Return(
+		&commits.ListTopReviewerResponse{
+			Data: []*commits.TopReviewer{
+				{Reviewer: "Sophie Chang <sophiechang@chromium.org>", ReviewsCount: 4},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits/top-reviewers?ownerName=chromium&repoName=chromium", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "sophiechang@chromium.org")
+}
+
+func TestSetAuthorAliases(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	config := &commits.AuthorAliasesConfig{
+		OwnerName:   "chromium",
+		Aliases:     []*commits.AuthorAlias{{Name: "Marc Treib", Email: "treib@chromium.org", Logins: []string{"mtreib"}}},
+		BotPatterns: []string{"*-autoroll@*"},
+	}
+	mockCommitsRPC.EXPECT().SetAuthorAliases(gomock.Any(), config).Times(1).Return(config, nil)
+
+	body := `{"ownerName":"chromium","aliases":[{"name":"Marc Treib","email":"treib@chromium.org","logins":["mtreib"]}],"botPatterns":["*-autoroll@*"]}`
+	rr := serve(t, router, http.MethodPut, "/authors/aliases", strings.NewReader(body))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Marc Treib")
+
+	for _, body := range []string{`{"repoName":"chromium"}`, `{"ownerName":"  ","repoName":"chromium"}`} {
+		rr = serve(t, router, http.MethodPut, "/authors/aliases", strings.NewReader(body))
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, body)
+	}
+}
+
+func TestListTopCommitAuthorTrends(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListTopCommitAuthor(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName:    "chromium",
+		RepoName:     "chromium",
+		GroupBy:      "week",
+		WindowDays:   30,
+		IncludeChurn: true,
+	}).Times(1).Return(
+		&commits.ListTopCommitAuthorResponse{
+			Buckets: []*commits.TopCommitAuthorBucket{
+				{
+					BucketStart: "2024-07-15",
+					BucketEnd:   "2024-07-21",
+					Data: []*commits.TopCommitAuthor{
+						{Author: "Marc Treib", CommitsCount: 7, Rank: 1, PreviousRank: 3, RankDelta: 2, Additions: 120},
+					},
+				},
+			},
+			CurrentWindow:  &commits.DateWindow{FromDate: "2024-06-23", ToDate: "2024-07-23"},
+			PreviousWindow: &commits.DateWindow{FromDate: "2024-05-24", ToDate: "2024-06-23"},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits/top-authors?ownerName=chromium&repoName=chromium&groupBy=week&windowDays=30&includeChurn=true", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var trends models.TopCommitAuthorTrends
+	assert.Nil(t, json.NewDecoder(rr.Body).Decode(&models.Result{Data: &trends}))
+	assert.Equal(t, "2024-07-15", trends.Buckets[0].BucketStart)
+	assert.Equal(t, 7, trends.Buckets[0].Data[0].CommitsCount)
+	assert.Equal(t, 2, trends.Buckets[0].Data[0].RankDelta)
+	assert.Equal(t, &models.DateWindow{FromDate: "2024-05-24", ToDate: "2024-06-23"}, trends.PreviousWindow)
+
+	for _, query := range []string{
+		"/commits/top-authors?ownerName=chromium&groupBy=year",
+		"/commits/top-authors?ownerName=chromium&windowDays=-1",
+	} {
+		rr = serve(t, router, http.MethodGet, query, nil)
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, query)
+	}
+}
+
+func TestGetCommitActivity(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().GetCommitActivity(gomock.Any(), &commits.CommitActivityParams{
+		Filter: &commits.CommitFilterParams{
+			OwnerName: "chromium",
+			RepoName:  "chromium",
+			FromDate:  "2024-07-01",
+			ToDate:    "2024-07-23",
+		},
+		Interval:  "week",
+		Breakdown: "author",
+	}).Times(1).Return(
+		&commits.CommitActivityResponse{
+			Buckets: []*commits.ActivityBucket{
+				{BucketStart: "2024-07-15", CommitsCount: 9, Breakdown: map[string]int64{"Marc Treib": 9}},
+			},
+			PunchCard: []*commits.PunchCardEntry{
+				{Weekday: 2, Hour: 14, CommitsCount: 9},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits/activity?ownerName=chromium&repoName=chromium&interval=week&breakdown=author&fromDate=2024-07-01&toDate=2024-07-23", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"bucketStart":"2024-07-15"`)
+	assert.Contains(t, rr.Body.String(), `"punchCard"`)
+
+	rr = serve(t, router, http.MethodGet, "/commits/activity?interval=hour", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestListOwnerCommits(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListCommits(gomock.Any(), &commits.CommitFilterParams{
+		Page:      1,
+		Limit:     10,
+		OwnerName: "brave",
+		RepoNames: []string{"brave-browser", "brave-core"},
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{OwnerName: "brave", RepoName: "brave-core", Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/orgs/brave/commits?repoName=brave-browser,brave-core&page=1&limit=10", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "brave-core")
+}
+
+func TestStartMonitoringOwnerRepositories(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().StartMonitoringOwnerRepositories(gomock.Any(), &commits.MonitorOwnerRepositoriesConfigParams{
+		OwnerName:       "brave",
+		DurationInHours: 2,
+		IncludeForks:    true,
+	}).Times(1).Return(&commits.Void{}, nil)
+
+	rr := serve(t, router, http.MethodPost, "/orgs/brave/start-monitoring", strings.NewReader(`{"durationInHours":2,"includeForks":true}`))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestExportCommits(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	stream := mocks.NewMockGitBeamCommitsService_ExportCommitsClient(gomock.NewController(t))
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&commits.Commit{Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01", Author: "Marc Treib"}, nil),
+		stream.EXPECT().Recv().Return(&commits.Commit{Sha: "d5dffa29e74bebe402cbf23c2b1c2ecf33e84971", Author: "Sophie Chang"}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	mockCommitsRPC.EXPECT().ExportCommits(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Times(1).Return(stream, nil)
+
+	req, err := http.NewRequest(http.MethodGet, "/commits/export?ownerName=chromium&repoName=chromium&format=ndjson", nil)
+	assert.Nil(t, err)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="commits.ndjson"`, rr.Header().Get("Content-Disposition"))
+	assert.Equal(t, "gzip", rr.Header().Get("Content-Encoding"))
+
+	reader, err := gzip.NewReader(rr.Body)
+	assert.Nil(t, err)
+	body, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, strings.Count(string(body), "\n"))
+	assert.Contains(t, string(body), "Sophie Chang")
+
+	rr = serve(t, router, http.MethodGet, "/commits/export?format=xlsx", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"format":"must be one of csv, ndjson or parquet"`)
+	assert.Contains(t, rr.Body.String(), `"ownerName":"cannot be blank"`)
+}
+
+func TestExportCommitsCutShort(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	stream := mocks.NewMockGitBeamCommitsService_ExportCommitsClient(gomock.NewController(t))
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&commits.Commit{Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01", Author: "Marc Treib"}, nil),
+		stream.EXPECT().Recv().Return(nil, errors.New("commit monitor went away")),
+	)
+
+	mockCommitsRPC.EXPECT().ExportCommits(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Times(1).Return(stream, nil)
+
+	gateway := httptest.NewServer(router)
+	defer gateway.Close()
+
+	req, err := http.NewRequest(http.MethodGet, gateway.URL+"/commits/export?ownerName=chromium&repoName=chromium&format=ndjson", nil)
+	assert.Nil(t, err)
+	req.Header.Set("Accept-Encoding", "gzip")
+	// The response is aborted rather than ended, so the client can't take the export for a complete one,
+	// whether the headers were flushed before the abort or not.
+	resp, err := http.DefaultClient.Do(req)
+	if err == nil {
+		defer resp.Body.Close()
+		_, err = io.ReadAll(resp.Body)
+	}
+	assert.NotNil(t, err)
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	for header, accepted := range map[string]bool{
+		"":                       false,
+		"gzip":                   true,
+		"deflate, GZIP":          true,
+		"gzip;q=0.5, br":         true,
+		"gzip;q=0":               false,
+		"gzip; q=0.000":          false,
+		"br, gzip;q=0, *":        false,
+		"*":                      true,
+		"*;q=0":                  false,
+		"identity, *;q=0.1":      true,
+		"gzip;q=zero, deflate":   false,
+		"gzip;level=9;q=0.8":     true,
+		"deflate;q=1, gzipx;q=1": false,
+	} {
+		assert.Equal(t, accepted, acceptsGzip(header), header)
+	}
+}
+
+func TestGetRepoChangelog(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListCommitsBetweenRefs(gomock.Any(), &commits.CommitRangeParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		From:      "128.0.6613.1",
+		To:        "2024-07-23",
+	}).Times(2).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{
+					Sha:     "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+					Message: "fix(history): drop HistoryDBTasks in Closing()",
+					Url:     "https://github.com/chromium/chromium/commit/fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+				},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/repos/chromium/chromium/changelog?from=128.0.6613.1&to=2024-07-23", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/markdown; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Body.String(), "### Bug Fixes")
+	assert.Contains(t, rr.Body.String(), "[fc4a5a4](https://github.com/chromium/chromium/commit/fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01)")
+
+	rr = serve(t, router, http.MethodGet, "/repos/chromium/chromium/changelog?from=128.0.6613.1&to=2024-07-23&format=json", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"title":"Bug Fixes"`)
+
+	rr = serve(t, router, http.MethodGet, "/repos/chromium/chromium/changelog?from=128.0.6613.1", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestListFirstParentHistory(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	sha := "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"
+	mockCommitsRPC.EXPECT().ListAncestors(gomock.Any(), &commits.CommitGraphParams{
+		OwnerName:   "chromium",
+		RepoName:    "chromium",
+		Sha:         sha,
+		Depth:       5,
+		FirstParent: true,
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{Sha: "a70fc91846eaa0da2db1de18b8f344b485eb7996"},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits/chromium/chromium/"+sha+"/history?depth=5", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "a70fc91846eaa0da2db1de18b8f344b485eb7996")
+
+	rr = serve(t, router, http.MethodGet, "/commits/chromium/chromium/"+sha+"/ancestors?depth=-1", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestIsAncestor(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().IsAncestor(gomock.Any(), &commits.CommitPairParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		ShaA:      "a70fc91846eaa0da2db1de18b8f344b485eb7996",
+		ShaB:      "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+	}).Times(1).Return(&commits.IsAncestorResponse{IsAncestor: true, Distance: 1}, nil)
+
+	rr := serve(t, router, http.MethodGet, "/commits/chromium/chromium/is-ancestor?ancestor=a70fc91846eaa0da2db1de18b8f344b485eb7996&descendant=fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"isAncestor":true`)
+}
+
+func TestListCommitsByConventionalType(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	breaking := true
+	mockCommitsRPC.EXPECT().ListCommits(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "Just4Ease",
+		RepoName:  "gitbeam",
+		Type:      "feat",
+		Scope:     "api",
+		Breaking:  &breaking,
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{
+					Sha:          "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+					Conventional: &commits.ConventionalCommit{Type: "feat", Scope: "api", Description: "drop v1 routes", Breaking: true},
+				},
+			},
+		},
+		nil,
+	)
+	mockCommitsRPC.EXPECT().ListCommitTypeBreakdown(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "Just4Ease",
+		RepoName:  "gitbeam",
+	}).Times(1).Return(
+		&commits.CommitTypeBreakdownResponse{
+			Data: []*commits.CommitTypeCount{
+				{Type: "feat", CommitsCount: 12, BreakingCount: 1},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/commits?ownerName=Just4Ease&repoName=gitbeam&type=feat&scope=api&breaking=true", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"description":"drop v1 routes"`)
+
+	rr = serve(t, router, http.MethodGet, "/commits/types?ownerName=Just4Ease&repoName=gitbeam", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"breakingCount":1`)
+}
+
+func TestListIssueCommits(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	mockCommitsRPC.EXPECT().ListIssueCommits(gomock.Any(), &commits.IssueCommitsParams{
+		Key:       "354474887",
+		OwnerName: "chromium",
+	}).Times(1).Return(
+		&commits.ListCommitResponse{
+			Data: []*commits.Commit{
+				{
+					Sha: "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+					Issues: []*commits.IssueReference{
+						{Key: "354474887", Url: "https://issues.chromium.org/issues/354474887", Pattern: "chromium-bug"},
+					},
+				},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/issues/354474887/commits?ownerName=chromium", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "https://issues.chromium.org/issues/354474887")
+}
+
+func TestSetIssuePatterns(t *testing.T) {
+	router, mockCommitsRPC, _ := newTestRouter(t)
+
+	config := &commits.IssuePatternsConfig{
+		OwnerName: "chromium",
+		Patterns:  []*commits.IssuePattern{{Name: "chromium-bug", Pattern: `Bug: (\d+)`, UrlTemplate: "https://issues.chromium.org/issues/{key}"}},
+	}
+	mockCommitsRPC.EXPECT().SetIssuePatterns(gomock.Any(), config).Times(1).Return(config, nil)
+
+	for body, statusCode := range map[string]int{
+		`{"ownerName":"chromium","patterns":[{"name":"chromium-bug","pattern":"Bug: (\\d+)","urlTemplate":"https://issues.chromium.org/issues/{key}"}]}`: http.StatusOK,
+		`{"ownerName":"chromium","patterns":[{"name":"no-group","pattern":"Bug: \\d+"}]}`:                                                                http.StatusUnprocessableEntity,
+		`{"ownerName":"chromium","patterns":[{"name":"bad-regex","pattern":"Bug: (\\d+"}]}`:                                                              http.StatusUnprocessableEntity,
+		`{"ownerName":"chromium","patterns":[{"name":"no-key","pattern":"JIRA-(\\d+)","urlTemplate":"https://jira.example.com/browse"}]}`:                http.StatusUnprocessableEntity,
+	} {
+		rr := serve(t, router, http.MethodPut, "/issues/patterns", strings.NewReader(body))
+		assert.Equal(t, statusCode, rr.Code, body)
+	}
+}
+
+func TestGetRepoStatsHistory(t *testing.T) {
+	router, _, mockRepoRPC := newTestRouter(t)
+
+	mockRepoRPC.EXPECT().GetRepoStatsHistory(gomock.Any(), &gitRepos.RepoStatsHistoryRequest{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Interval:  models.IntervalWeek,
+	}).Times(1).Return(
+		&gitRepos.RepoStatsHistoryResponse{
+			Data: []*gitRepos.RepoStatsSnapshot{
+				{RecordedAt: "2024-07-15T00:00:00Z", StarCounts: 18650, ForkCounts: 6700},
+				{RecordedAt: "2024-07-22T00:00:00Z", StarCounts: 18702, ForkCounts: 6712},
+			},
+		},
+		nil,
+	)
+
+	rr := serve(t, router, http.MethodGet, "/repos/chromium/chromium/stats/history?interval=week", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"starCounts":18702`)
+
+	rr = serve(t, router, http.MethodGet, "/repos/chromium/chromium/stats/history?interval=hour", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestRegisterRepository(t *testing.T) {
+	router, _, mockRepoRPC := newTestRouter(t)
+
+	mockRepoRPC.EXPECT().RegisterGitRepo(gomock.Any(), &gitRepos.RegisterGitRepoRequest{
+		OwnerName: "brave",
+		RepoName:  "brave-browser",
+	}).Times(2).Return(&gitRepos.Repo{Name: "brave-browser", Owner: "brave"}, nil)
+
+	for _, body := range []string{
+		`{"ownerName":"brave","repoName":"brave-browser"}`,
+		`{"url":"https://github.com/brave/brave-browser.git"}`,
+	} {
+		rr := serve(t, router, http.MethodPost, "/repos", strings.NewReader(body))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "brave-browser")
+	}
+
+	rr := serve(t, router, http.MethodPost, "/repos", strings.NewReader(`{"url":"https://gitlab.com/brave/brave-browser"}`))
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+// renamedRepo is brave/brave-browser, which went by brave/browser-laptop before it was renamed.
+func renamedRepo() *gitRepos.Repo {
+	return &gitRepos.Repo{
+		Name:       "brave-browser",
+		Owner:      "brave",
+		ProviderId: 71591187,
+		Status:     models.RepoStatusActive,
+		Aliases:    []*gitRepos.RepoAlias{{OwnerName: "brave", RepoName: "browser-laptop", Until: "2024-07-23T00:00:00Z"}},
+	}
+}
+
+func TestDeleteRepository(t *testing.T) {
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+
+	// Deleting through the old name acts on the current one.
+	gomock.InOrder(
+		mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+			OwnerName: "brave",
+			RepoName:  "browser-laptop",
+		}).Return(renamedRepo(), nil),
+		mockCommitsRPC.EXPECT().StopMonitoringRepositoryCommits(gomock.Any(), &commits.StopMonitoringRepositoryCommitParams{
+			OwnerName:    "brave",
+			RepoName:     "brave-browser",
+			PurgeCommits: true,
+		}).Return(&commits.Void{}, nil),
+		mockRepoRPC.EXPECT().DeleteGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+			OwnerName: "brave",
+			RepoName:  "brave-browser",
+		}).Return(&gitRepos.Void{}, nil),
+	)
+
+	rr := serve(t, router, http.MethodDelete, "/repos/brave/browser-laptop?purgeCommits=true", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestDeleteUnmonitoredRepository(t *testing.T) {
+	unmonitored := &gitRepos.Repo{Owner: "brave", Name: "brave-core"}
+	repoRequest := &gitRepos.GetGitRepoRequest{OwnerName: "brave", RepoName: "brave-core"}
+
+	// A repo registered but never monitored has nothing to stop.
+	router, _, mockRepoRPC := newTestRouter(t)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), repoRequest).Times(1).Return(unmonitored, nil)
+	mockRepoRPC.EXPECT().DeleteGitRepo(gomock.Any(), repoRequest).Times(1).Return(&gitRepos.Void{}, nil)
+
+	rr := serve(t, router, http.MethodDelete, "/repos/brave/brave-core", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Purging still asks the commit monitor, and it not knowing the repo doesn't stop the delete.
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), repoRequest).Times(1).Return(unmonitored, nil)
+	mockCommitsRPC.EXPECT().StopMonitoringRepositoryCommits(gomock.Any(), &commits.StopMonitoringRepositoryCommitParams{
+		OwnerName:    "brave",
+		RepoName:     "brave-core",
+		PurgeCommits: true,
+	}).Times(1).Return(nil, status.Error(codes.NotFound, "repo isn't monitored"))
+	mockRepoRPC.EXPECT().DeleteGitRepo(gomock.Any(), repoRequest).Times(1).Return(&gitRepos.Void{}, nil)
+
+	rr = serve(t, router, http.MethodDelete, "/repos/brave/brave-core?purgeCommits=true", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMonitoringRenamedRepo(t *testing.T) {
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+
+	// Starting and stopping through the old name monitor the commits under the current one.
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+		OwnerName: "brave",
+		RepoName:  "browser-laptop",
+	}).Times(2).Return(renamedRepo(), nil)
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       "brave",
+		RepoName:        "brave-browser",
+		DurationInHours: 1,
+	}).Times(1).Return(&commits.Void{}, nil)
+	mockCommitsRPC.EXPECT().StopMonitoringRepositoryCommits(gomock.Any(), &commits.StopMonitoringRepositoryCommitParams{
+		OwnerName: "brave",
+		RepoName:  "brave-browser",
+	}).Times(1).Return(&commits.Void{}, nil)
+
+	for path, body := range map[string]string{
+		"/commits/start-monitoring": `{"ownerName":"brave","repoName":"browser-laptop","durationInHours":1}`,
+		"/commits/stop-monitoring":  `{"ownerName":"brave","repoName":"browser-laptop"}`,
+	} {
+		rr := serve(t, router, http.MethodPost, path, strings.NewReader(body))
+		assert.Equal(t, http.StatusOK, rr.Code, path)
+	}
+}
+
+func TestGetCommitByShaOfRenamedRepo(t *testing.T) {
+	sha := "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"
+	commitRequest := func(repoName string) *commits.CommitByOwnerAndShaParams {
+		return &commits.CommitByOwnerAndShaParams{OwnerName: "brave", RepoName: repoName, Sha: sha}
+	}
+
+	testCases := []struct {
+		name   string
+		path   string
+		expect func(*mocks.MockGitBeamCommitsServiceClient, *mocks.MockGitBeamRepositoryServiceClient)
+		code   int
+	}{
+		{
+			name: "current name is found without resolving the repo",
+			path: "/commits/brave/brave-browser/" + sha,
+			expect: func(commitsRPC *mocks.MockGitBeamCommitsServiceClient, _ *mocks.MockGitBeamRepositoryServiceClient) {
+				commitsRPC.EXPECT().GetCommitByOwnerAndSHA(gomock.Any(), commitRequest("brave-browser")).
+					Times(1).Return(&commits.Commit{Sha: sha, RepoName: "brave-browser"}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "old name is resolved after a miss",
+			path: "/commits/brave/browser-laptop/" + sha,
+			expect: func(commitsRPC *mocks.MockGitBeamCommitsServiceClient, reposRPC *mocks.MockGitBeamRepositoryServiceClient) {
+				gomock.InOrder(
+					commitsRPC.EXPECT().GetCommitByOwnerAndSHA(gomock.Any(), commitRequest("browser-laptop")).
+						Times(1).Return(nil, errors.New("commit not found")),
+					reposRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+						OwnerName: "brave",
+						RepoName:  "browser-laptop",
+					}).Times(1).Return(renamedRepo(), nil),
+					commitsRPC.EXPECT().GetCommitByOwnerAndSHA(gomock.Any(), commitRequest("brave-browser")).
+						Times(1).Return(&commits.Commit{Sha: sha, RepoName: "brave-browser"}, nil),
+				)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "miss in a repo that was never renamed stays a bad request",
+			path: "/commits/brave/brave-core/" + sha,
+			expect: func(commitsRPC *mocks.MockGitBeamCommitsServiceClient, reposRPC *mocks.MockGitBeamRepositoryServiceClient) {
+				commitsRPC.EXPECT().GetCommitByOwnerAndSHA(gomock.Any(), commitRequest("brave-core")).
+					Times(1).Return(nil, errors.New("commit not found"))
+				reposRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+					OwnerName: "brave",
+					RepoName:  "brave-core",
+				}).Times(1).Return(&gitRepos.Repo{Owner: "brave", Name: "brave-core"}, nil)
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+			testCase.expect(mockCommitsRPC, mockRepoRPC)
+
+			rr := serve(t, router, http.MethodGet, testCase.path, nil)
+			assert.Equal(t, testCase.code, rr.Code)
+			if testCase.code == http.StatusOK {
+				assert.Contains(t, rr.Body.String(), `"repoName":"brave-browser"`)
+			}
+		})
+	}
+}
+
+func TestListRepoEvents(t *testing.T) {
+	router, _, mockRepoRPC := newTestRouter(t)
+
+	mockRepoRPC.EXPECT().ListRepoEvents(gomock.Any(), &gitRepos.ListRepoEventsRequest{
+		OwnerName: "brave",
+		RepoName:  "brave-browser",
+		Page:      1,
+		Limit:     10,
+	}).Times(1).Return(&gitRepos.ListRepoEventsResponse{
+		Data: []*gitRepos.RepoEvent{
+			{
+				Type:          models.RepoEventRenamed,
+				ProviderId:    71591187,
+				FromOwnerName: "brave",
+				FromRepoName:  "browser-laptop",
+				ToOwnerName:   "brave",
+				ToRepoName:    "brave-browser",
+				OccurredAt:    "2024-07-23T00:00:00Z",
+			},
+		},
+	}, nil)
+
+	rr := serve(t, router, http.MethodGet, "/repos/brave/brave-browser/events?page=1&limit=10", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"fromRepoName":"browser-laptop"`)
+}
+
+func TestGetRepoAndCommitMeta(t *testing.T) {
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+
+	file, err := os.Open("../seeds/brave.brave-browser.json")
+	assert.Nil(t, err)
+
+	var repo *gitRepos.Repo
+	assert.Nil(t, json.NewDecoder(file).Decode(&repo))
+
+	sha := "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01"
+	repoRequest := &gitRepos.GetGitRepoRequest{OwnerName: "brave", RepoName: "brave-browser"}
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), repoRequest).Times(1).Return(repo, nil)
+
+	mockCommitsRPC.EXPECT().GetCommitByOwnerAndSHA(gomock.Any(), &commits.CommitByOwnerAndShaParams{
+		OwnerName: "brave",
+		RepoName:  "brave-browser",
+		Sha:       sha,
+	}).Times(1).Return(&commits.Commit{
+		Sha: sha,
+		Meta: &commits.CommitMeta{
+			Author:       &commits.CommitIdentity{Name: "Marc Treib", Email: "treib@chromium.org"},
+			Committer:    &commits.CommitIdentity{Name: "Chromium LUCI CQ", Email: "chromium-scoped@luci-project-accounts.iam.gserviceaccount.com"},
+			Verification: &commits.CommitVerification{Verified: true, Reason: "valid"},
+		},
+	}, nil)
+
+	rr := serve(t, router, http.MethodGet, "/repos/brave/brave-browser", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"JavaScript":2418211`)
+	assert.Contains(t, rr.Body.String(), `"defaultBranch":"master"`)
+
+	rr = serve(t, router, http.MethodGet, "/commits/brave/brave-browser/"+sha, nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"committer":{"name":"Chromium LUCI CQ"`)
+	assert.Contains(t, rr.Body.String(), `"verified":true`)
+}
+
+func TestBulkMonitorRepoCommits(t *testing.T) {
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{OwnerName: "chromium", RepoName: "chromium"}).
+		Return(&gitRepos.Repo{Owner: "chromium", Name: "chromium"}, nil)
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       "chromium",
+		RepoName:        "chromium",
+		DurationInHours: 1,
+		Branches:        []string{"main"},
+	}).Return(&commits.Void{}, nil)
+
+	body := new(bytes.Buffer)
+	form := multipart.NewWriter(body)
+	part, err := form.CreateFormFile("manifest", "gitbeam.yaml")
+	assert.Nil(t, err)
+	_, err = part.Write([]byte("repos:\n  - ownerName: chromium\n    repoName: chromium\n    durationInHours: 1\n    branches: [main]\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, form.Close())
+
+	req, err := http.NewRequest(http.MethodPost, "/commits/bulk-monitoring?concurrency=4", body)
+	assert.Nil(t, err)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `{"ownerName":"chromium","repoName":"chromium","action":"start","success":true}`)
+
+	rr = serve(t, router, http.MethodPost, "/commits/bulk-monitoring", strings.NewReader(`{"repos": [{"ownerName": "chromium"}]}`))
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestValidation(t *testing.T) {
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+
+	// Only the valid payload reaches the RPCs.
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Return(&gitRepos.Repo{Owner: "chromium", Name: "chromium"}, nil)
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       "chromium",
+		RepoName:        "chromium",
+		FromDate:        "2024-07-01",
+		ToDate:          "2024-07-23",
+		DurationInHours: 1,
+	}).Return(&commits.Void{}, nil)
+
+	for body, fields := range map[string]map[string]any{
+		`{"ownerName":"chromium","repoName":"chromium","fromDate":"2024-07-01","toDate":"2024-07-23","durationInHours":1}`: nil,
+		`{"ownerName":"","repoName":"chromium"}`:                                                       {"ownerName": "cannot be blank"},
+		`{"ownerName":"chromium","repoName":"   "}`:                                                    {"repoName": "cannot be blank"},
+		`{"ownerName":"chromium","repoName":"chromium","durationInHours":-1}`:                          {"durationInHours": "must be no less than 1"},
+		`{"ownerName":"chromium","repoName":"chromium","durationInHours":0}`:                           {"durationInHours": "must be no less than 1"},
+		`{"ownerName":"chromium","repoName":"chromium","fromDate":"2024-07-23","toDate":"2024-07-01"}`: {"fromDate": "must not be after toDate"},
+		`{"ownerName":"chromium","repoName":"chromium","fromDate":"23/07/2024"}`:                       {"fromDate": "must be a valid date, e.g. 2024-07-01"},
+		`{"ownerName":"2024-13-01","repoName":"chromium","fromDate":"2024-13-01"}`:                     {"fromDate": "must be a valid date, e.g. 2024-07-01"},
+		`{"ownerName":"chromium","repoName":"chromium","toDate":20240723}`:                             {"toDate": "must be a valid date, e.g. 2024-07-01"},
+		`{"ownerName":"chromium","repoName":"chromium","durationInHours":"1"}`:                         {"durationInHours": "is invalid"},
+		`{"ownerName":"chromium","repoName":"chromium","webhooks":["hooks.example.com"]}`:              {"webhooks": map[string]any{"0": "must be an http or https url"}},
+	} {
+		rr := serve(t, router, http.MethodPost, "/commits/start-monitoring", strings.NewReader(body))
+
+		if fields == nil {
+			assert.Equal(t, http.StatusOK, rr.Code, body)
+			continue
+		}
+
+		var result models.Result
+		assert.Nil(t, json.NewDecoder(rr.Body).Decode(&result))
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, body)
+		assert.Equal(t, any(fields), result.Data, body)
+	}
+
+	for path, fields := range map[string]map[string]any{
+		"/commits?ownerName=chromium&limit=1000":                            {"limit": "must be no greater than 100"},
+		"/commits?ownerName=chromium&page=0&limit=-1":                       {"limit": "must be no less than 1"},
+		"/commits?ownerName=chromium&fromDate=2024-13-01":                   {"fromDate": "must be a valid date, e.g. 2024-07-01"},
+		"/commits?ownerName=chromium&fromDate=2024-07-23&toDate=2024-07-01": {"fromDate": "must not be after toDate"},
+		"/commits?ownerName=chromium&limit=ten&colour=blue":                 {"limit": "must be an integer", "colour": "is not a known parameter"},
+		"/commits?repoName=chromium":                                        {"ownerName": "cannot be blank"},
+		"/repos/chromium/chromium/changelog?from=a&to=b&groupBy=trailer.":   {"groupBy": "must be type or trailer.<Key>"},
+		"/repos/chromium/chromium/changelog?from=a&to=b&groupBy=author":     {"groupBy": "must be type or trailer.<Key>"},
+		"/commits/bulk-monitoring?concurrency=64":                           {"concurrency": "must be no greater than 32"},
+	} {
+		method := http.MethodGet
+		if strings.HasPrefix(path, "/commits/bulk-monitoring") {
+			method = http.MethodPost
+		}
+
+		rr := serve(t, router, method, path, nil)
+
+		var result models.Result
+		assert.Nil(t, json.NewDecoder(rr.Body).Decode(&result))
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, path)
+		assert.Equal(t, any(fields), result.Data, path)
+	}
+
+	// Malformed payloads are still bad requests.
+	rr := serve(t, router, http.MethodPost, "/commits/start-monitoring", strings.NewReader(`{"ownerName":`))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetReconcileStatus(t *testing.T) {
+	router, mockCommitsRPC, mockRepoRPC := newTestRouter(t)
+
+	rr := serve(t, router, http.MethodGet, "/admin/reconcile-status", nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	mockCommitsRPC.EXPECT().ListMonitoredRepositories(gomock.Any(), &commits.Void{}).Times(2).Return(
+		&commits.ListMonitoredRepositoriesResponse{
+			Data: []*commits.MonitorRepositoryCommitsConfigParams{
+				{OwnerName: "chromium", RepoName: "chromium", DurationInHours: 1},
+			},
+		}, nil)
+
+	path := filepath.Join(t.TempDir(), "gitbeam.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("repos:\n  - ownerName: chromium\n    repoName: chromium\n    durationInHours: 1\n"), 0o644))
+	reconciler := manifest.NewReconciler(path, time.Minute, manifest.NewApplier(mockCommitsRPC, mockRepoRPC), logrus.New())
+
+	// Run reconciles once before it notices the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reconciler.Run(ctx)
+
+	router = chi.NewMux()
+	New(mockCommitsRPC, mockRepoRPC, logrus.New()).WithReconciler(reconciler).Routes(router)
+	rr = serve(t, router, http.MethodGet, "/admin/reconcile-status", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"inSync":true`)
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	router, _, _ := newTestRouter(t)
+
+	// Every route is documented, and every documented route still exists.
+	walked := map[string]bool{}
+	err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		key := method + " " + openAPIPath(route)
+		walked[key] = true
+		assert.Contains(t, operations, key, "%s isn't documented in operations", key)
+		return nil
+	})
+	assert.Nil(t, err)
+	for key := range operations {
+		assert.True(t, walked[key], "%s is documented but not routed", key)
+	}
+
+	rr := serve(t, router, http.MethodGet, "/openapi.json", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var document struct {
+		OpenAPI    string                               `json:"openapi"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components map[string]map[string]map[string]any `json:"components"`
+	}
+	assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &document))
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+	assert.Len(t, document.Paths["/repos/{ownerName}/{repoName}"], 2)
+	assert.Equal(t, "getReposByOwnerNameByRepoName", document.Paths["/repos/{ownerName}/{repoName}"]["get"]["operationId"])
+	assert.Contains(t, document.Paths["/commits/start-monitoring"]["post"]["responses"], "422")
+
+	// The leaderboards document the models they serialize, with a single shape each.
+	assert.Contains(t, document.Components["schemas"]["TopCommitAuthor"]["properties"], "commitsCount")
+	assert.NotContains(t, rr.Body.String(), `"oneOf"`)
+	assert.Contains(t, document.Components["schemas"], "Commit")
+	assert.Contains(t, document.Components["schemas"], "commits.IsAncestorResponse")
+
+	// Every reference points at a schema of the components.
+	var refs []string
+	var collect func(node any)
+	collect = func(node any) {
+		switch node := node.(type) {
+		case map[string]any:
+			for key, value := range node {
+				if ref, ok := value.(string); ok && key == "$ref" {
+					refs = append(refs, ref)
+				}
+				collect(value)
+			}
+		case []any:
+			for _, value := range node {
+				collect(value)
+			}
+		}
+	}
+	var raw any
+	assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &raw))
+	collect(raw)
+	assert.NotEmpty(t, refs)
+	for _, ref := range refs {
+		assert.Contains(t, document.Components["schemas"], strings.TrimPrefix(ref, "#/components/schemas/"))
+	}
+
+	rr = serve(t, router, http.MethodGet, "/docs", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `url: "/openapi.json"`)
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"gitbeam/utils"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+func (a API) newAuthorsRoute() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/aliases", a.getAuthorAliases)
+	router.Put("/aliases", a.setAuthorAliases)
+
+	return router
+}
+
+func (a API) getAuthorAliases(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getAuthorAliases").Logger
+	var scope models.OwnerAndRepoName
+	err := decodeQuery(r.URL.Query(), &scope)
+	if err == nil {
+		err = scope.ValidateScope()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	config, err := a.commitsRPC.GetAuthorAliases(r.Context(), &commits.AuthorAliasesScope{
+		OwnerName: scope.OwnerName,
+		RepoName:  scope.RepoName,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to get author aliases")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved author aliases", config)
+}
+
+// setAuthorAliases replaces the author aliases and bot patterns of a repo, or of every repo of the
+// owner when repoName is left empty.
+func (a API) setAuthorAliases(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "setAuthorAliases").Logger
+
+	var payload models.AuthorAliasesConfig
+	err := decodeJSON(r.Body, &payload)
+	if err == nil {
+		err = payload.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid payload.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	var params commits.AuthorAliasesConfig
+	if err = utils.UnPack(payload, &params); err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	config, err := a.commitsRPC.SetAuthorAliases(r.Context(), &params)
+	if err != nil {
+		useLogger.WithError(err).Error("failed to set author aliases")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully updated author aliases", config)
+}
@@ -1,15 +1,27 @@
 package api
 
 import (
-	"encoding/json"
+	"compress/gzip"
 	"errors"
+	"fmt"
 	"gitbeam/api/pb/commits"
 	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/export"
+	"gitbeam/manifest"
 	"gitbeam/models"
 	"gitbeam/utils"
 	"github.com/go-chi/chi/v5"
-	"github.com/gorilla/schema"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	trailerQueryPrefix = "trailer."
+	maxManifestSize    = 1 << 20
 )
 
 func (a API) newCommitsRoute() chi.Router {
@@ -17,42 +29,110 @@ func (a API) newCommitsRoute() chi.Router {
 
 	router.Get("/", a.listCommits)
 	router.Get("/top-authors", a.listTopCommitAuthors)
+	router.Get("/top-reviewers", a.listTopReviewers)
+	router.Get("/types", a.listCommitTypeBreakdown)
+	router.Get("/search", a.searchCommits)
+	router.Get("/activity", a.getCommitActivity)
+	router.Get("/export", a.exportCommits)
 	router.Get("/{ownerName}/{repoName}/{sha}", a.getCommitBySha)
 	router.Post("/start-monitoring", a.startMonitoringRepoCommits)
 	router.Post("/stop-monitoring", a.stopMonitoringRepoCommits)
+	router.Post("/bulk-monitoring", a.bulkMonitorRepoCommits)
+	a.commitGraphRoutes(router)
 
 	return router
 }
 
 func (a API) listCommits(w http.ResponseWriter, r *http.Request) {
 	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listCommits").Logger
-	decoder := schema.NewDecoder()
-	var filter models.CommitFilters
-	if err := decoder.Decode(&filter, r.URL.Query()); err != nil {
-		useLogger.WithError(err).Error("failed to decode query params into filters.")
-		utils.WriteHTTPError(w, http.StatusBadRequest, errors.New("Bad/Invalid Query Parameters"))
+	filter, err := decodeCommitFilters(r.URL.Query())
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
 		return
 	}
 
 	useLogger.WithField("filter", filter).Info("filters")
-	rpcFilter := &commits.CommitFilterParams{
-		Page:      filter.Page,
-		Limit:     filter.Limit,
-		OwnerName: filter.OwnerName,
-		RepoName:  filter.RepoName,
-		FromDate:  "",
-		ToDate:    "",
+	rpcFilter := toCommitFilterParams(filter)
+
+	list, err := a.commitsRPC.ListCommits(r.Context(), rpcFilter)
+	if err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
 	}
 
-	if filter.FromDate != nil {
-		rpcFilter.FromDate = filter.FromDate.String()
+	utils.WriteHTTPSuccess(w, "Success", list.Data)
+}
+
+// listTopCommitAuthors returns the top authors leaderboard. groupBy splits it into weeks or months and
+// windowDays ranks the last days against the ones before them, data then holding the buckets and windows
+// along with the leaderboard.
+func (a API) listTopCommitAuthors(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listTopCommitAuthors").Logger
+	var filter models.TopAuthorTrendsFilters
+	err := decodeFilters(r.URL.Query(), &filter, &filter.Trailers)
+	if err == nil {
+		err = filter.Validate()
 	}
 
-	if filter.ToDate != nil {
-		rpcFilter.ToDate = filter.ToDate.String()
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
 	}
 
-	list, err := a.commitsRPC.ListCommits(r.Context(), rpcFilter)
+	useLogger.WithField("filter", filter).Info("filters")
+	rpcFilter := toCommitFilterParams(filter.CommitFilters)
+	rpcFilter.GroupBy = filter.GroupBy
+	rpcFilter.WindowDays = filter.WindowDays
+	rpcFilter.IncludeChurn = filter.IncludeChurn
+
+	list, err := a.commitsRPC.ListTopCommitAuthor(r.Context(), rpcFilter)
+	if err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if !filter.Trends() {
+		// Plain leaderboards keep the flat list they always had.
+		var authors []models.TopCommitAuthor
+		if err = utils.UnPack(list.Data, &authors); err != nil {
+			utils.WriteHTTPError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		utils.WriteHTTPSuccess(w, "Success", authors)
+		return
+	}
+
+	var trends models.TopCommitAuthorTrends
+	if err = utils.UnPack(list, &trends); err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Success", trends)
+}
+
+func (a API) listTopReviewers(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listTopReviewers").Logger
+	filter, err := decodeCommitFilters(r.URL.Query())
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	useLogger.WithField("filter", filter).Info("filters")
+	list, err := a.commitsRPC.ListTopReviewers(r.Context(), toCommitFilterParams(filter))
 	if err != nil {
 		utils.WriteHTTPError(w, http.StatusBadRequest, err)
 		return
@@ -61,24 +141,52 @@ func (a API) listCommits(w http.ResponseWriter, r *http.Request) {
 	utils.WriteHTTPSuccess(w, "Success", list.Data)
 }
 
-func (a API) listTopCommitAuthors(w http.ResponseWriter, r *http.Request) {
-	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listTopCommitAuthors").Logger
-	decoder := schema.NewDecoder()
-	var filter models.CommitFilters
-	if err := decoder.Decode(&filter, r.URL.Query()); err != nil {
-		useLogger.WithError(err).Error("failed to decode query params into filters.")
-		utils.WriteHTTPError(w, http.StatusBadRequest, errors.New("Bad/Invalid Query Parameters"))
+// listCommitTypeBreakdown counts the commits per conventional commit type, for release dashboards.
+func (a API) listCommitTypeBreakdown(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listCommitTypeBreakdown").Logger
+	filter, err := decodeCommitFilters(r.URL.Query())
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
 		return
 	}
 
 	useLogger.WithField("filter", filter).Info("filters")
-	rpcFilter := &commits.CommitFilterParams{
-		Page:      filter.Page,
-		Limit:     filter.Limit,
+	list, err := a.commitsRPC.ListCommitTypeBreakdown(r.Context(), toCommitFilterParams(filter))
+	if err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Success", list.Data)
+}
+
+func (a API) searchCommits(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "searchCommits").Logger
+	var filter models.CommitSearchFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid search query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	useLogger.WithField("filter", filter).Info("filters")
+	rpcFilter := &commits.SearchCommitsParams{
+		Query:     filter.Query,
 		OwnerName: filter.OwnerName,
 		RepoName:  filter.RepoName,
-		FromDate:  "",
-		ToDate:    "",
+		Author:    filter.Author,
+		Page:      filter.Page,
+		Limit:     filter.Limit,
 	}
 
 	if filter.FromDate != nil {
@@ -89,7 +197,7 @@ func (a API) listTopCommitAuthors(w http.ResponseWriter, r *http.Request) {
 		rpcFilter.ToDate = filter.ToDate.String()
 	}
 
-	list, err := a.commitsRPC.ListTopCommitAuthor(r.Context(), rpcFilter)
+	list, err := a.commitsRPC.SearchCommits(r.Context(), rpcFilter)
 	if err != nil {
 		utils.WriteHTTPError(w, http.StatusBadRequest, err)
 		return
@@ -98,6 +206,220 @@ func (a API) listTopCommitAuthors(w http.ResponseWriter, r *http.Request) {
 	utils.WriteHTTPSuccess(w, "Success", list.Data)
 }
 
+// getCommitActivity returns the commit counts per day, week or month, along with a punch-card of
+// commits per weekday and hour, so clients can chart activity without listing every commit.
+func (a API) getCommitActivity(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getCommitActivity").Logger
+	var filter models.CommitActivityFilters
+	err := decodeFilters(r.URL.Query(), &filter, &filter.Trailers)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	if filter.Interval == "" {
+		filter.Interval = models.IntervalDay
+	}
+
+	useLogger.WithField("filter", filter).Info("filters")
+	activity, err := a.commitsRPC.GetCommitActivity(r.Context(), &commits.CommitActivityParams{
+		Filter:    toCommitFilterParams(filter.CommitFilters),
+		Interval:  filter.Interval,
+		Breakdown: filter.Breakdown,
+	})
+	if err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Success", activity)
+}
+
+// exportCommits streams every commit matching the filters from the commits RPC into the response,
+// encoded as csv, ndjson or parquet, so exports never hold the whole result in memory.
+func (a API) exportCommits(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "exportCommits").Logger
+	var filter models.CommitExportFilters
+	err := decodeFilters(r.URL.Query(), &filter, &filter.Trailers)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	if filter.Format == "" {
+		filter.Format = export.FormatCSV
+	}
+
+	useLogger.WithField("filter", filter).Info("filters")
+	stream, err := a.commitsRPC.ExportCommits(r.Context(), toCommitFilterParams(filter.CommitFilters))
+	if err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Receive the first commit before writing headers, so RPC errors still get a JSON error response.
+	commit, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		useLogger.WithError(err).Error("failed to receive exported commits")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(filter.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="commits.%s"`, filter.Format))
+
+	var out io.Writer = w
+	var gzipWriter *gzip.Writer
+	if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Add("Vary", "Accept-Encoding")
+		gzipWriter = gzip.NewWriter(w)
+		out = gzipWriter
+	}
+
+	writer, err := export.NewWriter(filter.Format, out)
+	if err != nil {
+		useLogger.WithError(err).Error("failed to start the export")
+		panic(http.ErrAbortHandler)
+	}
+
+	exported := 0
+	for ; err == nil; commit, err = stream.Recv() {
+		if err = writer.Write(commit); err != nil {
+			break
+		}
+		exported++
+	}
+
+	if !errors.Is(err, io.EOF) {
+		// The status line is already sent, so the response is aborted: ending it, and its gzip stream,
+		// would pass the truncated export off as a complete one.
+		useLogger.WithError(err).WithField("exported", exported).Error("failed to export commits")
+		panic(http.ErrAbortHandler)
+	}
+
+	if err = writer.Close(); err == nil && gzipWriter != nil {
+		err = gzipWriter.Close()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("failed to finish commits export")
+	}
+}
+
+// acceptsGzip tells whether an Accept-Encoding header accepts gzip, either by name or through *, with a
+// q-value above 0.
+func acceptsGzip(header string) bool {
+	accepted := map[string]bool{}
+	for _, coding := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(coding, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "q") {
+				continue
+			}
+
+			// Malformed q-values don't accept the coding.
+			var err error
+			if q, err = strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil {
+				q = 0
+			}
+		}
+
+		if name != "" {
+			accepted[name] = q > 0
+		}
+	}
+
+	if gzip, ok := accepted["gzip"]; ok {
+		return gzip
+	}
+
+	return accepted["*"]
+}
+
+// decodeCommitFilters decodes the commit filters from the query params, trailer filters are passed
+// as `trailer.<Key>=<value>` ( e.g. trailer.Bug=354474887 ) and are collected into filter.Trailers.
+func decodeCommitFilters(query url.Values) (models.CommitFilters, error) {
+	var filter models.CommitFilters
+	err := decodeFilters(query, &filter, &filter.Trailers)
+	return filter, err
+}
+
+// decodeFilters decodes the query params into target, collecting the trailer filters into trailers.
+func decodeFilters(query url.Values, target any, trailers *map[string]string) error {
+	params := url.Values{}
+	for key, values := range query {
+		trailerKey, isTrailer := strings.CutPrefix(key, trailerQueryPrefix)
+		if !isTrailer {
+			params[key] = values
+			continue
+		}
+
+		if trailerKey == "" || len(values) == 0 {
+			return validation.Errors{key: errInvalidValue}
+		}
+
+		if *trailers == nil {
+			*trailers = make(map[string]string)
+		}
+		(*trailers)[trailerKey] = values[0]
+	}
+
+	return decodeQuery(params, target)
+}
+
+// toCommitFilterParams maps the query filters of the gateway onto the commits RPC filter params.
+func toCommitFilterParams(filter models.CommitFilters) *commits.CommitFilterParams {
+	rpcFilter := &commits.CommitFilterParams{
+		Page:        filter.Page,
+		Limit:       filter.Limit,
+		OwnerName:   filter.OwnerName,
+		RepoName:    filter.RepoName,
+		FromDate:    "",
+		ToDate:      "",
+		Branch:      filter.Branch,
+		Path:        filter.Path,
+		Trailers:    filter.Trailers,
+		IncludeBots: filter.IncludeBots,
+		Type:        strings.ToLower(filter.Type),
+		Scope:       filter.Scope,
+		Breaking:    filter.Breaking,
+	}
+
+	// repoName=a,b,c queries several repos of the owner at once.
+	if strings.Contains(filter.RepoName, ",") {
+		rpcFilter.RepoName = ""
+		for _, repoName := range strings.Split(filter.RepoName, ",") {
+			if repoName = strings.TrimSpace(repoName); repoName != "" {
+				rpcFilter.RepoNames = append(rpcFilter.RepoNames, repoName)
+			}
+		}
+	}
+
+	if filter.FromDate != nil {
+		rpcFilter.FromDate = filter.FromDate.String()
+	}
+
+	if filter.ToDate != nil {
+		rpcFilter.ToDate = filter.ToDate.String()
+	}
+
+	return rpcFilter
+}
+
 func (a API) getCommitBySha(w http.ResponseWriter, r *http.Request) {
 	owner := commits.CommitByOwnerAndShaParams{
 		OwnerName: chi.URLParam(r, "ownerName"),
@@ -106,6 +428,19 @@ func (a API) getCommitBySha(w http.ResponseWriter, r *http.Request) {
 	}
 
 	commit, err := a.commitsRPC.GetCommitByOwnerAndSHA(r.Context(), &owner)
+	if err != nil {
+		// The commits are mirrored under the current names of a repo, so on a miss resolve the names
+		// it went by before a rename or transfer and look again.
+		repo, repoErr := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
+			OwnerName: owner.OwnerName,
+			RepoName:  owner.RepoName,
+		})
+		if repoErr == nil && (!strings.EqualFold(repo.Owner, owner.OwnerName) || !strings.EqualFold(repo.Name, owner.RepoName)) {
+			owner.OwnerName, owner.RepoName = repo.Owner, repo.Name
+			commit, err = a.commitsRPC.GetCommitByOwnerAndSHA(r.Context(), &owner)
+		}
+	}
+
 	if err != nil {
 		statusNotFound := http.StatusBadRequest
 
@@ -124,14 +459,19 @@ func (a API) getCommitBySha(w http.ResponseWriter, r *http.Request) {
 func (a API) startMonitoringRepoCommits(w http.ResponseWriter, r *http.Request) {
 	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "startMonitoringRepoCommits").Logger
 
-	var payload commits.MonitorRepositoryCommitsConfigParams
-	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		useLogger.WithError(err).Error("failed to decode query params into filters.")
-		utils.WriteHTTPError(w, http.StatusBadRequest, errors.New("Bad/Invalid Query Parameters"))
+	var payload models.MirrorRepoCommitsRequest
+	err := decodeJSON(r.Body, &payload)
+	if err == nil {
+		err = payload.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid payload.")
+		utils.WriteHTTPValidationError(w, err)
 		return
 	}
 
-	_, err := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
+	repo, err := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
 		OwnerName: payload.OwnerName,
 		RepoName:  payload.RepoName,
 	})
@@ -141,7 +481,27 @@ func (a API) startMonitoringRepoCommits(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	_, err = a.commitsRPC.StartMonitoringRepositoryCommits(r.Context(), &payload)
+	params := &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       repo.Owner,
+		RepoName:        repo.Name,
+		Branches:        payload.Branches,
+		EnrichDiffStats: payload.EnrichDiffStats,
+		Webhooks:        payload.Webhooks,
+	}
+
+	if payload.DurationInHours != nil {
+		params.DurationInHours = *payload.DurationInHours
+	}
+
+	if payload.FromDate != nil {
+		params.FromDate = payload.FromDate.String()
+	}
+
+	if payload.ToDate != nil {
+		params.ToDate = payload.ToDate.String()
+	}
+
+	_, err = a.commitsRPC.StartMonitoringRepositoryCommits(r.Context(), params)
 	if err != nil {
 		useLogger.WithError(err).Error("failed to start monitoring repository commits")
 		statusCode := http.StatusBadRequest
@@ -155,13 +515,20 @@ func (a API) startMonitoringRepoCommits(w http.ResponseWriter, r *http.Request)
 func (a API) stopMonitoringRepoCommits(w http.ResponseWriter, r *http.Request) {
 	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "stopMonitoringRepoCommits").Logger
 	var payload models.OwnerAndRepoName
-	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		useLogger.WithError(err).Error("error decoding payload")
-		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+	err := decodeJSON(r.Body, &payload)
+	if err == nil {
+		err = payload.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid payload.")
+		utils.WriteHTTPValidationError(w, err)
 		return
 	}
 
-	_, err := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
+	// The commits are mirrored under the current names of the repo, which the repo manager resolves
+	// the names it went by before a rename or transfer to.
+	repo, err := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
 		OwnerName: payload.OwnerName,
 		RepoName:  payload.RepoName,
 	})
@@ -172,8 +539,8 @@ func (a API) stopMonitoringRepoCommits(w http.ResponseWriter, r *http.Request) {
 	}
 
 	_, err = a.commitsRPC.StopMonitoringRepositoryCommits(r.Context(), &commits.StopMonitoringRepositoryCommitParams{
-		OwnerName: payload.OwnerName,
-		RepoName:  payload.RepoName,
+		OwnerName: repo.Owner,
+		RepoName:  repo.Name,
 	})
 	if err != nil {
 		useLogger.WithError(err).Error("failed to stop monitoring repository commits")
@@ -184,3 +551,52 @@ func (a API) stopMonitoringRepoCommits(w http.ResponseWriter, r *http.Request) {
 	//
 	utils.WriteHTTPSuccess(w, "Successfully stopped monitoring repo commits.", nil)
 }
+
+// bulkMonitorRepoCommits starts monitoring every repo of a manifest, sent as a JSON or YAML body or
+// uploaded as the manifest file of a multipart form. With sync=true, the monitored repos the manifest
+// doesn't list are stopped, so the commit monitor ends up matching the manifest.
+func (a API) bulkMonitorRepoCommits(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "bulkMonitorRepoCommits").Logger
+	var filter models.BulkMonitoringFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid bulk monitoring query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxManifestSize)
+	body := io.Reader(r.Body)
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("manifest")
+		if err != nil {
+			useLogger.WithError(err).Error("failed to read manifest file")
+			utils.WriteHTTPError(w, http.StatusBadRequest, errors.New("manifest file is required"))
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	m, err := manifest.Parse(body)
+	if err != nil {
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	report, err := manifest.NewApplier(a.commitsRPC, a.reposRPC).Apply(r.Context(), m, manifest.Options{
+		Sync:        filter.Sync,
+		Concurrency: filter.Concurrency,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to apply monitoring manifest")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully applied monitoring manifest.", report)
+}
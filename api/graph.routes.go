@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"gitbeam/utils"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"net/http"
+)
+
+// commitGraphRoutes registers the routes walking the parentCommitIDs of the mirrored commits onto the
+// commits router, they never call GitHub.
+func (a API) commitGraphRoutes(router chi.Router) {
+	router.Get("/{ownerName}/{repoName}/merge-base", a.getMergeBase)
+	router.Get("/{ownerName}/{repoName}/is-ancestor", a.isAncestor)
+	router.Get("/{ownerName}/{repoName}/{sha}/ancestors", a.listAncestors)
+	router.Get("/{ownerName}/{repoName}/{sha}/descendants", a.listDescendants)
+	router.Get("/{ownerName}/{repoName}/{sha}/history", a.listFirstParentHistory)
+}
+
+func (a API) listAncestors(w http.ResponseWriter, r *http.Request) {
+	a.walkCommitGraph(w, r, "listAncestors", false, a.commitsRPC.ListAncestors)
+}
+
+func (a API) listDescendants(w http.ResponseWriter, r *http.Request) {
+	a.walkCommitGraph(w, r, "listDescendants", false, a.commitsRPC.ListDescendants)
+}
+
+// listFirstParentHistory lists the ancestors of a commit following first parents only, which is the
+// history of the branch it was merged into.
+func (a API) listFirstParentHistory(w http.ResponseWriter, r *http.Request) {
+	a.walkCommitGraph(w, r, "listFirstParentHistory", true, a.commitsRPC.ListAncestors)
+}
+
+type commitGraphRPC func(ctx context.Context, in *commits.CommitGraphParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error)
+
+func (a API) walkCommitGraph(w http.ResponseWriter, r *http.Request, endpointName string, firstParent bool, walk commitGraphRPC) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", endpointName).Logger
+	var filter models.CommitGraphFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	list, err := walk(r.Context(), &commits.CommitGraphParams{
+		OwnerName:   chi.URLParam(r, "ownerName"),
+		RepoName:    chi.URLParam(r, "repoName"),
+		Sha:         chi.URLParam(r, "sha"),
+		Depth:       filter.Depth,
+		FirstParent: firstParent || filter.FirstParent,
+		Limit:       filter.Limit,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to walk commit graph")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Success", list.Data)
+}
+
+func (a API) getMergeBase(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getMergeBase").Logger
+	var pair models.CommitPair
+	err := decodeQuery(r.URL.Query(), &pair)
+	if err == nil {
+		err = pair.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	commit, err := a.commitsRPC.GetMergeBase(r.Context(), &commits.CommitPairParams{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		ShaA:      pair.A,
+		ShaB:      pair.B,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to get merge base")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved merge base", commit)
+}
+
+// isAncestor answers whether the ancestor commit is in the history of the descendant one, e.g.
+// whether a fix is in the build that was shipped.
+func (a API) isAncestor(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "isAncestor").Logger
+	var pair models.AncestorPair
+	err := decodeQuery(r.URL.Query(), &pair)
+	if err == nil {
+		err = pair.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	result, err := a.commitsRPC.IsAncestor(r.Context(), &commits.CommitPairParams{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		ShaA:      pair.Ancestor,
+		ShaB:      pair.Descendant,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to check commit ancestry")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Success", result)
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"gitbeam/utils"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+func (a API) newIssuesRoute() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/patterns", a.getIssuePatterns)
+	router.Put("/patterns", a.setIssuePatterns)
+	router.Get("/{key}/commits", a.listIssueCommits)
+
+	return router
+}
+
+func (a API) listIssueCommits(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listIssueCommits").Logger
+	var filter models.IssueCommitsFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	list, err := a.commitsRPC.ListIssueCommits(r.Context(), &commits.IssueCommitsParams{
+		Key:       chi.URLParam(r, "key"),
+		OwnerName: filter.OwnerName,
+		RepoName:  filter.RepoName,
+		Page:      filter.Page,
+		Limit:     filter.Limit,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch commits of issue")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Success", list.Data)
+}
+
+func (a API) getIssuePatterns(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getIssuePatterns").Logger
+	var scope models.OwnerAndRepoName
+	err := decodeQuery(r.URL.Query(), &scope)
+	if err == nil {
+		err = scope.ValidateScope()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	config, err := a.commitsRPC.GetIssuePatterns(r.Context(), &commits.RepoScope{
+		OwnerName: scope.OwnerName,
+		RepoName:  scope.RepoName,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to get issue patterns")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved issue patterns", config)
+}
+
+// setIssuePatterns replaces the issue patterns of a repo, or of every repo of the owner when repoName
+// is left empty.
+func (a API) setIssuePatterns(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "setIssuePatterns").Logger
+
+	var payload models.IssuePatternsConfig
+	err := decodeJSON(r.Body, &payload)
+	if err == nil {
+		err = payload.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid payload.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	var params commits.IssuePatternsConfig
+	if err = utils.UnPack(payload, &params); err != nil {
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	config, err := a.commitsRPC.SetIssuePatterns(r.Context(), &params)
+	if err != nil {
+		useLogger.WithError(err).Error("failed to set issue patterns")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully updated issue patterns", config)
+}
@@ -0,0 +1,394 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"gitbeam/models"
+	"gitbeam/utils"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const openAPIVersion = "3.0.3"
+
+// openAPIDocument is the subset of the OpenAPI 3 document the gateway describes itself with.
+type openAPIDocument struct {
+	OpenAPI    string                            `json:"openapi"`
+	Info       openAPIInfo                       `json:"info"`
+	Paths      map[string]map[string]openAPIOp   `json:"paths"`
+	Components map[string]map[string]*jsonSchema `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openAPIOp struct {
+	Tags        []string                   `json:"tags"`
+	Summary     string                     `json:"summary"`
+	Description string                     `json:"description,omitempty"`
+	OperationID string                     `json:"operationId"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIBody               `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string      `json:"name"`
+	In          string      `json:"in"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Schema      *jsonSchema `json:"schema"`
+}
+
+type openAPIBody struct {
+	Required bool                    `json:"required"`
+	Content  map[string]openAPIMedia `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                  `json:"description"`
+	Content     map[string]openAPIMedia `json:"content,omitempty"`
+}
+
+type openAPIMedia struct {
+	Schema *jsonSchema `json:"schema"`
+}
+
+type jsonSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+}
+
+// getOpenAPISpec serves the OpenAPI document of the routes of router.
+func (a API) getOpenAPISpec(router chi.Routes) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		document, err := newOpenAPIDocument(router)
+		if err != nil {
+			a.logger.WithError(err).Error("failed to generate the openapi document")
+			utils.WriteHTTPError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_ = json.NewEncoder(w).Encode(document)
+	}
+}
+
+// getSwaggerUI serves a Swagger UI page of the OpenAPI document.
+func (a API) getSwaggerUI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(swaggerUIPage))
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>GitBeam API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
+
+var pathParamPattern = regexp.MustCompile(`{([^}]+)}`)
+
+// newOpenAPIDocument walks the routes of router and describes each with its entry in operations.
+// Routes without one are an error, so the document can't silently fall behind the router.
+func newOpenAPIDocument(router chi.Routes) (openAPIDocument, error) {
+	schemas := newSchemaRegistry()
+	document := openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       "GitBeam gateway",
+			Description: "Mirrors the commits of GitHub repositories. Every JSON response is wrapped in the Result envelope, errors included.",
+			Version:     "1.0.0",
+		},
+		Paths: map[string]map[string]openAPIOp{},
+	}
+
+	err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		route = openAPIPath(route)
+		op, ok := operations[method+" "+route]
+		if !ok {
+			return fmt.Errorf("%s %s isn't documented, describe it in operations", method, route)
+		}
+
+		if document.Paths[route] == nil {
+			document.Paths[route] = map[string]openAPIOp{}
+		}
+		document.Paths[route][strings.ToLower(method)] = op.describe(method, route, schemas)
+		return nil
+	})
+	if err != nil {
+		return document, err
+	}
+
+	document.Components = map[string]map[string]*jsonSchema{"schemas": schemas.components}
+	return document, nil
+}
+
+// openAPIPath drops the trailing slash chi gives the root route of a mounted router, e.g. /repos/.
+func openAPIPath(route string) string {
+	if len(route) > 1 {
+		return strings.TrimSuffix(route, "/")
+	}
+
+	return route
+}
+
+// operation documents a route, query, body and response are zero values of the types it decodes the
+// query string and payload into, and of the data of its success envelope.
+type operation struct {
+	summary     string
+	description string
+	query       any
+	body        any
+	// bodyTypes are the content types the body is accepted in, defaults to application/json.
+	bodyTypes []string
+	response  any
+	// contentTypes are the content types of responses that aren't wrapped in the envelope.
+	contentTypes []string
+	notFound     bool
+}
+
+func (op operation) describe(method, route string, schemas *schemaRegistry) openAPIOp {
+	tag := strings.Split(strings.Trim(route, "/"), "/")[0]
+	described := openAPIOp{
+		Tags:        []string{tag},
+		Summary:     op.summary,
+		Description: op.description,
+		OperationID: operationID(method, route),
+		Responses: map[string]openAPIResponse{
+			"400": {Description: "Invalid request, or the RPC failed", Content: envelope(schemas, nil)},
+		},
+	}
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route, -1) {
+		described.Parameters = append(described.Parameters, openAPIParameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &jsonSchema{Type: "string"},
+		})
+	}
+
+	if op.query != nil {
+		described.Parameters = append(described.Parameters, queryParameters(reflect.TypeOf(op.query), schemas)...)
+	}
+
+	if op.body != nil {
+		bodyTypes := op.bodyTypes
+		if len(bodyTypes) == 0 {
+			bodyTypes = []string{"application/json"}
+		}
+
+		described.RequestBody = &openAPIBody{Required: true, Content: map[string]openAPIMedia{}}
+		for _, contentType := range bodyTypes {
+			schema := schemas.of(reflect.TypeOf(op.body))
+			if contentType == "multipart/form-data" {
+				schema = &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{
+					"manifest": {Type: "string", Format: "binary"},
+				}}
+			}
+			described.RequestBody.Content[contentType] = openAPIMedia{Schema: schema}
+		}
+	}
+
+	success := openAPIResponse{Description: "Success", Content: map[string]openAPIMedia{}}
+	if op.response != nil || len(op.contentTypes) == 0 {
+		success.Content = envelope(schemas, op.response)
+	}
+
+	for _, contentType := range op.contentTypes {
+		success.Content[contentType] = openAPIMedia{Schema: &jsonSchema{Type: "string", Format: "binary"}}
+	}
+	described.Responses["200"] = success
+
+	if op.query != nil || op.body != nil {
+		described.Responses["422"] = openAPIResponse{
+			Description: "Invalid fields, data holding the error of each field",
+			Content:     envelope(schemas, map[string]any{}),
+		}
+	}
+
+	if op.notFound {
+		described.Responses["404"] = openAPIResponse{Description: "Not found", Content: envelope(schemas, nil)}
+	}
+
+	return described
+}
+
+// envelope describes the Result envelope, with data of the type of the zero value data when it isn't nil.
+func envelope(schemas *schemaRegistry, data any) map[string]openAPIMedia {
+	schema := schemas.of(reflect.TypeOf(models.Result{}))
+	if data != nil {
+		schema = &jsonSchema{AllOf: []*jsonSchema{schema, {
+			Type:       "object",
+			Properties: map[string]*jsonSchema{"data": schemas.of(reflect.TypeOf(data))},
+		}}}
+	}
+
+	return map[string]openAPIMedia{"application/json": {Schema: schema}}
+}
+
+// operationID names an operation after its route, e.g. GET /repos/{ownerName}/{repoName} is getReposByOwnerNameByRepoName.
+func operationID(method, route string) string {
+	var id strings.Builder
+	id.WriteString(strings.ToLower(method))
+	for _, segment := range strings.FieldsFunc(route, func(r rune) bool { return r == '/' || r == '-' }) {
+		if name, ok := strings.CutPrefix(segment, "{"); ok {
+			id.WriteString("By")
+			segment = strings.TrimSuffix(name, "}")
+		}
+		id.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+	}
+
+	return id.String()
+}
+
+// queryParameters lists the query parameters a filter type is decoded from with gorilla/schema.
+func queryParameters(t reflect.Type, schemas *schemaRegistry) []openAPIParameter {
+	var parameters []openAPIParameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("schema"), ",")
+		if field.Anonymous && name == "" {
+			parameters = append(parameters, queryParameters(field.Type, schemas)...)
+			continue
+		}
+
+		if field.Name == "Trailers" {
+			parameters = append(parameters, openAPIParameter{
+				Name:        "trailer.{Key}",
+				In:          "query",
+				Description: "Only the commits with this value of the trailer, e.g. trailer.Bug=b/1234",
+				Schema:      &jsonSchema{Type: "string"},
+			})
+			continue
+		}
+
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		parameters = append(parameters, openAPIParameter{Name: name, In: "query", Schema: schemas.of(field.Type)})
+	}
+
+	return parameters
+}
+
+// schemaRegistry describes Go types as JSON schemas, structs are described once in components and
+// referenced from then on.
+type schemaRegistry struct {
+	components map[string]*jsonSchema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{components: map[string]*jsonSchema{}}
+}
+
+var (
+	dateType = reflect.TypeOf(models.Date{})
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+func (s *schemaRegistry) of(t reflect.Type) *jsonSchema {
+	switch t {
+	case dateType:
+		return &jsonSchema{Type: "string", Format: "date"}
+	case timeType:
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return s.of(t.Elem())
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &jsonSchema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &jsonSchema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &jsonSchema{Type: "string", Format: "byte"}
+		}
+		return &jsonSchema{Type: "array", Items: s.of(t.Elem())}
+	case reflect.Map:
+		return &jsonSchema{Type: "object", AdditionalProperties: s.of(t.Elem())}
+	case reflect.Struct:
+		return s.component(t)
+	}
+
+	// Interfaces, e.g. the data of the envelope, can hold anything.
+	return &jsonSchema{}
+}
+
+// component describes a struct in components and returns a reference to it. Types outside of models
+// are named after their package, e.g. commits.IsAncestorResponse, as some share the names of models.
+func (s *schemaRegistry) component(t reflect.Type) *jsonSchema {
+	name := t.Name()
+	if pkg := t.PkgPath(); !strings.HasSuffix(pkg, "/models") {
+		name = pkg[strings.LastIndex(pkg, "/")+1:] + "." + name
+	}
+
+	ref := &jsonSchema{Ref: "#/components/schemas/" + name}
+	if _, ok := s.components[name]; ok {
+		return ref
+	}
+
+	schema := &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{}}
+	s.components[name] = schema
+	s.properties(t, schema.Properties)
+	return ref
+}
+
+// properties collects the fields of a struct the way encoding/json marshals them, embedded structs
+// being flattened.
+func (s *schemaRegistry) properties(t reflect.Type, properties map[string]*jsonSchema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			s.properties(embedded, properties)
+			continue
+		}
+
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = s.of(field.Type)
+	}
+}
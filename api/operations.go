@@ -0,0 +1,221 @@
+package api
+
+import (
+	"gitbeam/api/pb/commits"
+	"gitbeam/changelog"
+	"gitbeam/export"
+	"gitbeam/manifest"
+	"gitbeam/models"
+)
+
+// operations documents every route of the gateway, keyed by method and route. newOpenAPIDocument
+// fails on routes missing from it.
+var operations = map[string]operation{
+	"GET /openapi.json": {
+		summary:      "OpenAPI document of the gateway",
+		contentTypes: []string{"application/json"},
+	},
+	"GET /docs": {
+		summary:      "Swagger UI of the OpenAPI document",
+		contentTypes: []string{"text/html"},
+	},
+
+	"GET /repos": {
+		summary:  "List repositories",
+		query:    models.RepoFilters{},
+		response: []models.Repo{},
+	},
+	"POST /repos": {
+		summary:     "Register a repository",
+		description: "Registers a repository by ownerName and repoName, or by its GitHub url. Registering a known repository refreshes it.",
+		body:        models.RegisterRepoRequest{},
+		response:    models.Repo{},
+	},
+	"GET /repos/{ownerName}/{repoName}": {
+		summary:  "Get a repository",
+		response: models.Repo{},
+		notFound: true,
+	},
+	"DELETE /repos/{ownerName}/{repoName}": {
+		summary:     "Delete a repository",
+		description: "Stops monitoring the commits of the repository and removes it, purgeCommits also deletes what was mirrored so far.",
+		query:       models.DeleteRepoFilters{},
+		notFound:    true,
+	},
+	"POST /repos/{ownerName}/{repoName}/refresh": {
+		summary:  "Re-pull the metadata of a repository from GitHub",
+		response: models.Repo{},
+	},
+	"GET /repos/{ownerName}/{repoName}/changelog": {
+		summary:      "Changelog between two refs",
+		description:  "Returns markdown unless format=json.",
+		query:        models.ChangelogFilters{},
+		response:     changelog.Changelog{},
+		contentTypes: []string{"text/markdown"},
+	},
+	"GET /repos/{ownerName}/{repoName}/events": {
+		summary:  "List the renames, transfers, archivals and deletions of a repository",
+		query:    models.Pagination{},
+		response: []models.RepoEvent{},
+	},
+	"GET /repos/{ownerName}/{repoName}/releases": {
+		summary:  "List the releases of a repository",
+		query:    models.Pagination{},
+		response: []models.Release{},
+	},
+	"GET /repos/{ownerName}/{repoName}/stats/history": {
+		summary:  "Star, fork, open issue and watcher counts of a repository over time",
+		query:    models.RepoStatsHistoryFilters{},
+		response: []models.RepoStatsSnapshot{},
+	},
+	"GET /repos/{ownerName}/{repoName}/tags": {
+		summary:  "List the tags of a repository",
+		query:    models.Pagination{},
+		response: []models.Tag{},
+	},
+	"GET /repos/{ownerName}/{repoName}/tags/compare": {
+		summary:  "List the commits reachable from toTag but not from fromTag",
+		query:    models.TagRange{},
+		response: []models.Commit{},
+	},
+
+	"GET /commits": {
+		summary:     "List commits",
+		description: "repoName=a,b narrows the query to several repositories of the owner.",
+		query:       models.CommitFilters{},
+		response:    []models.Commit{},
+	},
+	"GET /commits/activity": {
+		summary:  "Commit counts over time, and the punch card",
+		query:    models.CommitActivityFilters{},
+		response: models.CommitActivity{},
+	},
+	"GET /commits/export": {
+		summary: "Stream the commits matching the filters as csv, ndjson or parquet",
+		query:   models.CommitExportFilters{},
+		contentTypes: []string{
+			export.ContentType(export.FormatCSV),
+			export.ContentType(export.FormatNDJSON),
+			export.ContentType(export.FormatParquet),
+		},
+	},
+	"GET /commits/search": {
+		summary:  "Full text search of commit messages",
+		query:    models.CommitSearchFilters{},
+		response: []models.CommitSearchResult{},
+	},
+	"GET /commits/top-authors": {
+		summary:     "Leaderboard of commit authors",
+		description: "groupBy splits the leaderboard into buckets, windowDays ranks the last days against the ones before them. With either, data is a TopCommitAuthorTrends.",
+		query:       models.TopAuthorTrendsFilters{},
+		response:    []models.TopCommitAuthor{},
+	},
+	"GET /commits/top-reviewers": {
+		summary:  "Leaderboard of reviewers, from the Reviewed-by trailers",
+		query:    models.CommitFilters{},
+		response: []models.TopReviewer{},
+	},
+	"GET /commits/types": {
+		summary:  "Commit counts by conventional commit type",
+		query:    models.CommitFilters{},
+		response: []models.CommitTypeCount{},
+	},
+	"GET /commits/{ownerName}/{repoName}/{sha}": {
+		summary:  "Get a commit",
+		response: models.Commit{},
+		notFound: true,
+	},
+	"GET /commits/{ownerName}/{repoName}/merge-base": {
+		summary:  "Best common ancestor of two commits",
+		query:    models.CommitPair{},
+		response: models.Commit{},
+	},
+	"GET /commits/{ownerName}/{repoName}/is-ancestor": {
+		summary:  "Whether a commit is an ancestor of another",
+		query:    models.AncestorPair{},
+		response: commits.IsAncestorResponse{},
+	},
+	"GET /commits/{ownerName}/{repoName}/{sha}/ancestors": {
+		summary:  "List the ancestors of a commit",
+		query:    models.CommitGraphFilters{},
+		response: []models.Commit{},
+	},
+	"GET /commits/{ownerName}/{repoName}/{sha}/descendants": {
+		summary:  "List the descendants of a commit",
+		query:    models.CommitGraphFilters{},
+		response: []models.Commit{},
+	},
+	"GET /commits/{ownerName}/{repoName}/{sha}/history": {
+		summary:  "First parent history of a commit",
+		query:    models.CommitGraphFilters{},
+		response: []models.Commit{},
+	},
+	"POST /commits/start-monitoring": {
+		summary: "Start monitoring the commits of a repository",
+		body:    models.MirrorRepoCommitsRequest{},
+	},
+	"POST /commits/stop-monitoring": {
+		summary: "Stop monitoring the commits of a repository",
+		body:    models.OwnerAndRepoName{},
+	},
+	"POST /commits/bulk-monitoring": {
+		summary:     "Start, and with sync stop, monitoring the repositories of a manifest",
+		description: "The manifest is a YAML or JSON body, or the manifest file of a multipart form.",
+		query:       models.BulkMonitoringFilters{},
+		body:        manifest.Manifest{},
+		bodyTypes:   []string{"application/json", "application/yaml", "multipart/form-data"},
+		response:    manifest.Report{},
+	},
+
+	"GET /authors/aliases": {
+		summary:  "Get the author aliases and bot patterns of a repository, or of an owner",
+		query:    models.OwnerAndRepoName{},
+		response: models.AuthorAliasesConfig{},
+	},
+	"PUT /authors/aliases": {
+		summary:  "Replace the author aliases and bot patterns of a repository, or of an owner",
+		body:     models.AuthorAliasesConfig{},
+		response: models.AuthorAliasesConfig{},
+	},
+
+	"GET /orgs/{ownerName}/commits": {
+		summary:  "List the commits of every monitored repository of an owner",
+		query:    models.CommitFilters{},
+		response: []models.Commit{},
+	},
+	"GET /orgs/{ownerName}/top-authors": {
+		summary:     "Leaderboard of commit authors across every monitored repository of an owner",
+		description: "Takes groupBy and windowDays as GET /commits/top-authors does.",
+		query:       models.TopAuthorTrendsFilters{},
+		response:    []models.TopCommitAuthor{},
+	},
+	"POST /orgs/{ownerName}/start-monitoring": {
+		summary: "Monitor every repository of an owner, including the ones created later on",
+		body:    models.MonitorOwnerRepositoriesRequest{},
+	},
+	"POST /orgs/{ownerName}/stop-monitoring": {
+		summary: "Stop monitoring the repositories of an owner",
+	},
+
+	"GET /issues/patterns": {
+		summary:  "Get the issue reference patterns of a repository, or of an owner",
+		query:    models.OwnerAndRepoName{},
+		response: models.IssuePatternsConfig{},
+	},
+	"PUT /issues/patterns": {
+		summary:  "Replace the issue reference patterns of a repository, or of an owner",
+		body:     models.IssuePatternsConfig{},
+		response: models.IssuePatternsConfig{},
+	},
+	"GET /issues/{key}/commits": {
+		summary:  "List the commits referencing an issue",
+		query:    models.IssueCommitsFilters{},
+		response: []models.Commit{},
+	},
+
+	"GET /admin/reconcile-status": {
+		summary:  "Last reconciliation of the monitoring config file, and the drift from it",
+		response: manifest.Status{},
+		notFound: true,
+	},
+}
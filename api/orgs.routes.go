@@ -0,0 +1,91 @@
+package api
+
+import (
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"gitbeam/utils"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+func (a API) newOrgsRoute() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/{ownerName}/commits", a.withOwnerName(a.listCommits))
+	router.Get("/{ownerName}/top-authors", a.withOwnerName(a.listTopCommitAuthors))
+	router.Post("/{ownerName}/start-monitoring", a.startMonitoringOwnerRepositories)
+	router.Post("/{ownerName}/stop-monitoring", a.stopMonitoringOwnerRepositories)
+
+	return router
+}
+
+// withOwnerName scopes the commit filters of next to the owner in the URL, so the owner level
+// views are served by the same handlers as the repo level ones.
+func (a API) withOwnerName(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		query.Set("ownerName", chi.URLParam(r, "ownerName"))
+		r.URL.RawQuery = query.Encode()
+		next(w, r)
+	}
+}
+
+func (a API) startMonitoringOwnerRepositories(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "startMonitoringOwnerRepositories").Logger
+
+	var payload models.MonitorOwnerRepositoriesRequest
+	err := decodeJSON(r.Body, &payload)
+	if err == nil {
+		err = payload.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid payload.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	params := &commits.MonitorOwnerRepositoriesConfigParams{
+		OwnerName:       chi.URLParam(r, "ownerName"),
+		Branches:        payload.Branches,
+		EnrichDiffStats: payload.EnrichDiffStats,
+		IncludeForks:    payload.IncludeForks,
+		IncludeArchived: payload.IncludeArchived,
+	}
+
+	if payload.DurationInHours != nil {
+		params.DurationInHours = *payload.DurationInHours
+	}
+
+	if payload.FromDate != nil {
+		params.FromDate = payload.FromDate.String()
+	}
+
+	if payload.ToDate != nil {
+		params.ToDate = payload.ToDate.String()
+	}
+
+	_, err = a.commitsRPC.StartMonitoringOwnerRepositories(r.Context(), params)
+	if err != nil {
+		useLogger.WithError(err).Error("failed to start monitoring owner repositories")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully started monitoring owner repositories.", nil)
+}
+
+func (a API) stopMonitoringOwnerRepositories(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "stopMonitoringOwnerRepositories").Logger
+
+	_, err := a.commitsRPC.StopMonitoringOwnerRepositories(r.Context(), &commits.StopMonitoringOwnerRepositoriesParams{
+		OwnerName: chi.URLParam(r, "ownerName"),
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to stop monitoring owner repositories")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully stopped monitoring owner repositories.", nil)
+}
@@ -76,7 +76,20 @@ type Commit struct {
 	Url             string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
 	Sha             string   `protobuf:"bytes,7,opt,name=sha,proto3" json:"sha,omitempty"`
 	ParentCommitIDs []string `protobuf:"bytes,8,rep,name=parentCommitIDs,proto3" json:"parentCommitIDs,omitempty"`
-	Meta            string   `protobuf:"bytes,9,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Branches the commit was seen on while mirroring.
+	Branches []string `protobuf:"bytes,10,rep,name=branches,proto3" json:"branches,omitempty"`
+	// Diff stats and changed files, only set when the monitor enriches commits.
+	Stats *CommitStats  `protobuf:"bytes,11,opt,name=stats,proto3" json:"stats,omitempty"`
+	Files []*CommitFile `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty"`
+	// Git trailers (e.g. Bug, Change-Id, Reviewed-by) parsed from the message, in order of appearance.
+	Trailers    []*Trailer `protobuf:"bytes,13,rep,name=trailers,proto3" json:"trailers,omitempty"`
+	AuthorEmail string     `protobuf:"bytes,14,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
+	AuthorLogin string     `protobuf:"bytes,15,opt,name=authorLogin,proto3" json:"authorLogin,omitempty"`
+	// Only set when the message follows conventional commits.
+	Conventional *ConventionalCommit `protobuf:"bytes,16,opt,name=conventional,proto3" json:"conventional,omitempty"`
+	// Issue tracker references extracted from the message by the repo's issue patterns.
+	Issues []*IssueReference `protobuf:"bytes,17,rep,name=issues,proto3" json:"issues,omitempty"`
+	Meta   *CommitMeta       `protobuf:"bytes,18,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
 func (x *Commit) Reset() {
@@ -167,24 +180,82 @@ func (x *Commit) GetParentCommitIDs() []string {
 	return nil
 }
 
-func (x *Commit) GetMeta() string {
+func (x *Commit) GetBranches() []string {
 	if x != nil {
-		return x.Meta
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *Commit) GetStats() *CommitStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Commit) GetFiles() []*CommitFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Commit) GetTrailers() []*Trailer {
+	if x != nil {
+		return x.Trailers
+	}
+	return nil
+}
+
+func (x *Commit) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
 	}
 	return ""
 }
 
-type TopCommitAuthor struct {
+func (x *Commit) GetAuthorLogin() string {
+	if x != nil {
+		return x.AuthorLogin
+	}
+	return ""
+}
+
+func (x *Commit) GetConventional() *ConventionalCommit {
+	if x != nil {
+		return x.Conventional
+	}
+	return nil
+}
+
+func (x *Commit) GetIssues() []*IssueReference {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *Commit) GetMeta() *CommitMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type CommitMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Author       string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	CommitsCount int64  `protobuf:"varint,2,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+	// author is who wrote the change, committer who applied it, they differ e.g. on cherry-picks and rebases.
+	Author       *CommitIdentity     `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Committer    *CommitIdentity     `protobuf:"bytes,2,opt,name=committer,proto3" json:"committer,omitempty"`
+	Verification *CommitVerification `protobuf:"bytes,3,opt,name=verification,proto3" json:"verification,omitempty"`
 }
 
-func (x *TopCommitAuthor) Reset() {
-	*x = TopCommitAuthor{}
+func (x *CommitMeta) Reset() {
+	*x = CommitMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,13 +263,13 @@ func (x *TopCommitAuthor) Reset() {
 	}
 }
 
-func (x *TopCommitAuthor) String() string {
+func (x *CommitMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopCommitAuthor) ProtoMessage() {}
+func (*CommitMeta) ProtoMessage() {}
 
-func (x *TopCommitAuthor) ProtoReflect() protoreflect.Message {
+func (x *CommitMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -210,40 +281,45 @@ func (x *TopCommitAuthor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopCommitAuthor.ProtoReflect.Descriptor instead.
-func (*TopCommitAuthor) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitMeta.ProtoReflect.Descriptor instead.
+func (*CommitMeta) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TopCommitAuthor) GetAuthor() string {
+func (x *CommitMeta) GetAuthor() *CommitIdentity {
 	if x != nil {
 		return x.Author
 	}
-	return ""
+	return nil
 }
 
-func (x *TopCommitAuthor) GetCommitsCount() int64 {
+func (x *CommitMeta) GetCommitter() *CommitIdentity {
 	if x != nil {
-		return x.CommitsCount
+		return x.Committer
 	}
-	return 0
+	return nil
 }
 
-type CommitFilterParams struct {
+func (x *CommitMeta) GetVerification() *CommitVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+type CommitIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page      int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit     int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	OwnerName string `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
-	RepoName  string `protobuf:"bytes,4,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	FromDate  string `protobuf:"bytes,5,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
-	ToDate    string `protobuf:"bytes,6,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Login string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	Date  string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *CommitFilterParams) Reset() {
-	*x = CommitFilterParams{}
+func (x *CommitIdentity) Reset() {
+	*x = CommitIdentity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,13 +327,13 @@ func (x *CommitFilterParams) Reset() {
 	}
 }
 
-func (x *CommitFilterParams) String() string {
+func (x *CommitIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitFilterParams) ProtoMessage() {}
+func (*CommitIdentity) ProtoMessage() {}
 
-func (x *CommitFilterParams) ProtoReflect() protoreflect.Message {
+func (x *CommitIdentity) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -269,65 +345,54 @@ func (x *CommitFilterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitFilterParams.ProtoReflect.Descriptor instead.
-func (*CommitFilterParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitIdentity.ProtoReflect.Descriptor instead.
+func (*CommitIdentity) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CommitFilterParams) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *CommitFilterParams) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *CommitFilterParams) GetOwnerName() string {
+func (x *CommitIdentity) GetName() string {
 	if x != nil {
-		return x.OwnerName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CommitFilterParams) GetRepoName() string {
+func (x *CommitIdentity) GetEmail() string {
 	if x != nil {
-		return x.RepoName
+		return x.Email
 	}
 	return ""
 }
 
-func (x *CommitFilterParams) GetFromDate() string {
+func (x *CommitIdentity) GetLogin() string {
 	if x != nil {
-		return x.FromDate
+		return x.Login
 	}
 	return ""
 }
 
-func (x *CommitFilterParams) GetToDate() string {
+func (x *CommitIdentity) GetDate() string {
 	if x != nil {
-		return x.ToDate
+		return x.Date
 	}
 	return ""
 }
 
-type CommitByOwnerAndShaParams struct {
+// Whether GitHub verified the signature of the commit.
+type CommitVerification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
-	Sha       string `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	// GitHub's reason, e.g. valid, unsigned or bad_email.
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Payload   string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *CommitByOwnerAndShaParams) Reset() {
-	*x = CommitByOwnerAndShaParams{}
+func (x *CommitVerification) Reset() {
+	*x = CommitVerification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +400,13 @@ func (x *CommitByOwnerAndShaParams) Reset() {
 	}
 }
 
-func (x *CommitByOwnerAndShaParams) String() string {
+func (x *CommitVerification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitByOwnerAndShaParams) ProtoMessage() {}
+func (*CommitVerification) ProtoMessage() {}
 
-func (x *CommitByOwnerAndShaParams) ProtoReflect() protoreflect.Message {
+func (x *CommitVerification) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,42 +418,52 @@ func (x *CommitByOwnerAndShaParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitByOwnerAndShaParams.ProtoReflect.Descriptor instead.
-func (*CommitByOwnerAndShaParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitVerification.ProtoReflect.Descriptor instead.
+func (*CommitVerification) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CommitByOwnerAndShaParams) GetOwnerName() string {
+func (x *CommitVerification) GetVerified() bool {
 	if x != nil {
-		return x.OwnerName
+		return x.Verified
+	}
+	return false
+}
+
+func (x *CommitVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CommitByOwnerAndShaParams) GetRepoName() string {
+func (x *CommitVerification) GetSignature() string {
 	if x != nil {
-		return x.RepoName
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *CommitByOwnerAndShaParams) GetSha() string {
+func (x *CommitVerification) GetPayload() string {
 	if x != nil {
-		return x.Sha
+		return x.Payload
 	}
 	return ""
 }
 
-type HealthCheckResponse struct {
+type IssueReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int64 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Name of the issue pattern that matched.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+func (x *IssueReference) Reset() {
+	*x = IssueReference{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,13 +471,13 @@ func (x *HealthCheckResponse) Reset() {
 	}
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *IssueReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*IssueReference) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *IssueReference) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,28 +489,46 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueReference.ProtoReflect.Descriptor instead.
+func (*IssueReference) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HealthCheckResponse) GetCode() int64 {
+func (x *IssueReference) GetKey() string {
 	if x != nil {
-		return x.Code
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-type ListCommitResponse struct {
+func (x *IssueReference) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IssueReference) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// Conventional commits (https://www.conventionalcommits.org) breakdown of a commit message.
+type ConventionalCommit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*Commit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Scope       string `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Breaking    bool   `protobuf:"varint,4,opt,name=breaking,proto3" json:"breaking,omitempty"`
 }
 
-func (x *ListCommitResponse) Reset() {
-	*x = ListCommitResponse{}
+func (x *ConventionalCommit) Reset() {
+	*x = ConventionalCommit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,13 +536,13 @@ func (x *ListCommitResponse) Reset() {
 	}
 }
 
-func (x *ListCommitResponse) String() string {
+func (x *ConventionalCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommitResponse) ProtoMessage() {}
+func (*ConventionalCommit) ProtoMessage() {}
 
-func (x *ListCommitResponse) ProtoReflect() protoreflect.Message {
+func (x *ConventionalCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,28 +554,50 @@ func (x *ListCommitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommitResponse.ProtoReflect.Descriptor instead.
-func (*ListCommitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConventionalCommit.ProtoReflect.Descriptor instead.
+func (*ConventionalCommit) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListCommitResponse) GetData() []*Commit {
+func (x *ConventionalCommit) GetType() string {
 	if x != nil {
-		return x.Data
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type ListTopCommitAuthorResponse struct {
+func (x *ConventionalCommit) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ConventionalCommit) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ConventionalCommit) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+type Trailer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*TopCommitAuthor `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ListTopCommitAuthorResponse) Reset() {
-	*x = ListTopCommitAuthorResponse{}
+func (x *Trailer) Reset() {
+	*x = Trailer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,13 +605,13 @@ func (x *ListTopCommitAuthorResponse) Reset() {
 	}
 }
 
-func (x *ListTopCommitAuthorResponse) String() string {
+func (x *Trailer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopCommitAuthorResponse) ProtoMessage() {}
+func (*Trailer) ProtoMessage() {}
 
-func (x *ListTopCommitAuthorResponse) ProtoReflect() protoreflect.Message {
+func (x *Trailer) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,32 +623,37 @@ func (x *ListTopCommitAuthorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopCommitAuthorResponse.ProtoReflect.Descriptor instead.
-func (*ListTopCommitAuthorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Trailer.ProtoReflect.Descriptor instead.
+func (*Trailer) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListTopCommitAuthorResponse) GetData() []*TopCommitAuthor {
+func (x *Trailer) GetKey() string {
 	if x != nil {
-		return x.Data
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type MonitorRepositoryCommitsConfigParams struct {
+func (x *Trailer) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CommitStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerName       string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	RepoName        string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
-	FromDate        string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
-	ToDate          string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
-	DurationInHours int64  `protobuf:"varint,5,opt,name=durationInHours,proto3" json:"durationInHours,omitempty"`
+	Additions int64 `protobuf:"varint,1,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions int64 `protobuf:"varint,2,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Total     int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) Reset() {
-	*x = MonitorRepositoryCommitsConfigParams{}
+func (x *CommitStats) Reset() {
+	*x = CommitStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,13 +661,13 @@ func (x *MonitorRepositoryCommitsConfigParams) Reset() {
 	}
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) String() string {
+func (x *CommitStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitorRepositoryCommitsConfigParams) ProtoMessage() {}
+func (*CommitStats) ProtoMessage() {}
 
-func (x *MonitorRepositoryCommitsConfigParams) ProtoReflect() protoreflect.Message {
+func (x *CommitStats) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,57 +679,47 @@ func (x *MonitorRepositoryCommitsConfigParams) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitorRepositoryCommitsConfigParams.ProtoReflect.Descriptor instead.
-func (*MonitorRepositoryCommitsConfigParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitStats.ProtoReflect.Descriptor instead.
+func (*CommitStats) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetOwnerName() string {
-	if x != nil {
-		return x.OwnerName
-	}
-	return ""
-}
-
-func (x *MonitorRepositoryCommitsConfigParams) GetRepoName() string {
-	if x != nil {
-		return x.RepoName
-	}
-	return ""
-}
-
-func (x *MonitorRepositoryCommitsConfigParams) GetFromDate() string {
+func (x *CommitStats) GetAdditions() int64 {
 	if x != nil {
-		return x.FromDate
+		return x.Additions
 	}
-	return ""
+	return 0
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetToDate() string {
+func (x *CommitStats) GetDeletions() int64 {
 	if x != nil {
-		return x.ToDate
+		return x.Deletions
 	}
-	return ""
+	return 0
 }
 
-func (x *MonitorRepositoryCommitsConfigParams) GetDurationInHours() int64 {
+func (x *CommitStats) GetTotal() int64 {
 	if x != nil {
-		return x.DurationInHours
+		return x.Total
 	}
 	return 0
 }
 
-type StopMonitoringRepositoryCommitParams struct {
+type CommitFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
-	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Filename         string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Status           string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Additions        int64  `protobuf:"varint,3,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions        int64  `protobuf:"varint,4,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Changes          int64  `protobuf:"varint,5,opt,name=changes,proto3" json:"changes,omitempty"`
+	PreviousFilename string `protobuf:"bytes,6,opt,name=previousFilename,proto3" json:"previousFilename,omitempty"`
 }
 
-func (x *StopMonitoringRepositoryCommitParams) Reset() {
-	*x = StopMonitoringRepositoryCommitParams{}
+func (x *CommitFile) Reset() {
+	*x = CommitFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_commits_commits_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,13 +727,13 @@ func (x *StopMonitoringRepositoryCommitParams) Reset() {
 	}
 }
 
-func (x *StopMonitoringRepositoryCommitParams) String() string {
+func (x *CommitFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopMonitoringRepositoryCommitParams) ProtoMessage() {}
+func (*CommitFile) ProtoMessage() {}
 
-func (x *StopMonitoringRepositoryCommitParams) ProtoReflect() protoreflect.Message {
+func (x *CommitFile) ProtoReflect() protoreflect.Message {
 	mi := &file_commits_commits_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,551 +745,5489 @@ func (x *StopMonitoringRepositoryCommitParams) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopMonitoringRepositoryCommitParams.ProtoReflect.Descriptor instead.
-func (*StopMonitoringRepositoryCommitParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitFile.ProtoReflect.Descriptor instead.
+func (*CommitFile) Descriptor() ([]byte, []int) {
 	return file_commits_commits_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StopMonitoringRepositoryCommitParams) GetOwnerName() string {
+func (x *CommitFile) GetFilename() string {
 	if x != nil {
-		return x.OwnerName
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *StopMonitoringRepositoryCommitParams) GetRepoName() string {
+func (x *CommitFile) GetStatus() string {
 	if x != nil {
-		return x.RepoName
+		return x.Status
 	}
 	return ""
 }
 
-var File_commits_commits_proto protoreflect.FileDescriptor
+func (x *CommitFile) GetAdditions() int64 {
+	if x != nil {
+		return x.Additions
+	}
+	return 0
+}
 
-var file_commits_commits_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
-	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x4d, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x67, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42,
-	0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x22, 0x29,
-	0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xbe, 0x01, 0x0a, 0x24, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x22, 0x60, 0x0a, 0x24, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x4e, 0x61, 0x6d, 0x65, 0x32, 0x94, 0x04, 0x0a, 0x15, 0x47, 0x69, 0x74, 0x42, 0x65, 0x61, 0x6d,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64,
-	0x53, 0x48, 0x41, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68,
-	0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x24,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1f, 0x53, 0x74, 0x6f, 0x70,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2e,
-	0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CommitFile) GetDeletions() int64 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
 }
 
-var (
-	file_commits_commits_proto_rawDescOnce sync.Once
-	file_commits_commits_proto_rawDescData = file_commits_commits_proto_rawDesc
-)
-
-func file_commits_commits_proto_rawDescGZIP() []byte {
-	file_commits_commits_proto_rawDescOnce.Do(func() {
-		file_commits_commits_proto_rawDescData = protoimpl.X.CompressGZIP(file_commits_commits_proto_rawDescData)
-	})
-	return file_commits_commits_proto_rawDescData
+func (x *CommitFile) GetChanges() int64 {
+	if x != nil {
+		return x.Changes
+	}
+	return 0
 }
 
-var file_commits_commits_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_commits_commits_proto_goTypes = []interface{}{
-	(*Void)(nil),                                 // 0: commits.Void
-	(*Commit)(nil),                               // 1: commits.Commit
-	(*TopCommitAuthor)(nil),                      // 2: commits.TopCommitAuthor
-	(*CommitFilterParams)(nil),                   // 3: commits.CommitFilterParams
-	(*CommitByOwnerAndShaParams)(nil),            // 4: commits.CommitByOwnerAndShaParams
-	(*HealthCheckResponse)(nil),                  // 5: commits.HealthCheckResponse
-	(*ListCommitResponse)(nil),                   // 6: commits.ListCommitResponse
-	(*ListTopCommitAuthorResponse)(nil),          // 7: commits.ListTopCommitAuthorResponse
-	(*MonitorRepositoryCommitsConfigParams)(nil), // 8: commits.MonitorRepositoryCommitsConfigParams
-	(*StopMonitoringRepositoryCommitParams)(nil), // 9: commits.StopMonitoringRepositoryCommitParams
+func (x *CommitFile) GetPreviousFilename() string {
+	if x != nil {
+		return x.PreviousFilename
+	}
+	return ""
 }
-var file_commits_commits_proto_depIdxs = []int32{
-	1, // 0: commits.ListCommitResponse.data:type_name -> commits.Commit
-	2, // 1: commits.ListTopCommitAuthorResponse.data:type_name -> commits.TopCommitAuthor
-	3, // 2: commits.GitBeamCommitsService.ListCommits:input_type -> commits.CommitFilterParams
-	4, // 3: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:input_type -> commits.CommitByOwnerAndShaParams
-	3, // 4: commits.GitBeamCommitsService.ListTopCommitAuthor:input_type -> commits.CommitFilterParams
-	0, // 5: commits.GitBeamCommitsService.HealthCheck:input_type -> commits.Void
-	8, // 6: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:input_type -> commits.MonitorRepositoryCommitsConfigParams
-	9, // 7: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:input_type -> commits.StopMonitoringRepositoryCommitParams
-	6, // 8: commits.GitBeamCommitsService.ListCommits:output_type -> commits.ListCommitResponse
-	1, // 9: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:output_type -> commits.Commit
-	7, // 10: commits.GitBeamCommitsService.ListTopCommitAuthor:output_type -> commits.ListTopCommitAuthorResponse
-	5, // 11: commits.GitBeamCommitsService.HealthCheck:output_type -> commits.HealthCheckResponse
-	0, // 12: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:output_type -> commits.Void
-	0, // 13: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:output_type -> commits.Void
-	8, // [8:14] is the sub-list for method output_type
-	2, // [2:8] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+type TopCommitAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author       string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	CommitsCount int64  `protobuf:"varint,2,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+	// Canonical email and login of the resolved identity the commits were aggregated on.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Login string `protobuf:"bytes,4,opt,name=login,proto3" json:"login,omitempty"`
+	// Position on the leaderboard, and on the one of the previous window when windowDays is set.
+	Rank                 int64  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	PreviousRank         int64  `protobuf:"varint,6,opt,name=previousRank,proto3" json:"previousRank,omitempty"`
+	RankDelta            int64  `protobuf:"varint,7,opt,name=rankDelta,proto3" json:"rankDelta,omitempty"`
+	PreviousCommitsCount int64  `protobuf:"varint,8,opt,name=previousCommitsCount,proto3" json:"previousCommitsCount,omitempty"`
+	FirstCommitDate      string `protobuf:"bytes,9,opt,name=firstCommitDate,proto3" json:"firstCommitDate,omitempty"`
+	LastCommitDate       string `protobuf:"bytes,10,opt,name=lastCommitDate,proto3" json:"lastCommitDate,omitempty"`
+	// Line churn totals, only set when includeChurn is requested.
+	Additions int64 `protobuf:"varint,11,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions int64 `protobuf:"varint,12,opt,name=deletions,proto3" json:"deletions,omitempty"`
 }
 
-func init() { file_commits_commits_proto_init() }
-func file_commits_commits_proto_init() {
-	if File_commits_commits_proto != nil {
-		return
+func (x *TopCommitAuthor) Reset() {
+	*x = TopCommitAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_commits_commits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Void); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopCommitAuthor); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitFilterParams); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitByOwnerAndShaParams); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTopCommitAuthorResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorRepositoryCommitsConfigParams); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_commits_commits_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopMonitoringRepositoryCommitParams); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *TopCommitAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopCommitAuthor) ProtoMessage() {}
+
+func (x *TopCommitAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_commits_commits_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_commits_commits_proto_goTypes,
-		DependencyIndexes: file_commits_commits_proto_depIdxs,
-		MessageInfos:      file_commits_commits_proto_msgTypes,
-	}.Build()
-	File_commits_commits_proto = out.File
-	file_commits_commits_proto_rawDesc = nil
-	file_commits_commits_proto_goTypes = nil
-	file_commits_commits_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Deprecated: Use TopCommitAuthor.ProtoReflect.Descriptor instead.
+func (*TopCommitAuthor) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{10}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (x *TopCommitAuthor) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
 
-// GitBeamCommitsServiceClient is the client API for GitBeamCommitsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GitBeamCommitsServiceClient interface {
-	ListCommits(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
-	GetCommitByOwnerAndSHA(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*Commit, error)
-	ListTopCommitAuthor(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListTopCommitAuthorResponse, error)
-	HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error)
-	StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error)
-	StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error)
+func (x *TopCommitAuthor) GetCommitsCount() int64 {
+	if x != nil {
+		return x.CommitsCount
+	}
+	return 0
 }
 
-type gitBeamCommitsServiceClient struct {
-	cc grpc.ClientConnInterface
+func (x *TopCommitAuthor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func NewGitBeamCommitsServiceClient(cc grpc.ClientConnInterface) GitBeamCommitsServiceClient {
-	return &gitBeamCommitsServiceClient{cc}
+func (x *TopCommitAuthor) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *TopCommitAuthor) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TopCommitAuthor) GetPreviousRank() int64 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *TopCommitAuthor) GetRankDelta() int64 {
+	if x != nil {
+		return x.RankDelta
+	}
+	return 0
+}
+
+func (x *TopCommitAuthor) GetPreviousCommitsCount() int64 {
+	if x != nil {
+		return x.PreviousCommitsCount
+	}
+	return 0
+}
+
+func (x *TopCommitAuthor) GetFirstCommitDate() string {
+	if x != nil {
+		return x.FirstCommitDate
+	}
+	return ""
+}
+
+func (x *TopCommitAuthor) GetLastCommitDate() string {
+	if x != nil {
+		return x.LastCommitDate
+	}
+	return ""
+}
+
+func (x *TopCommitAuthor) GetAdditions() int64 {
+	if x != nil {
+		return x.Additions
+	}
+	return 0
+}
+
+func (x *TopCommitAuthor) GetDeletions() int64 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
+}
+
+// Leaderboard of a single week or month when grouping top authors.
+type TopCommitAuthorBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketStart string             `protobuf:"bytes,1,opt,name=bucketStart,proto3" json:"bucketStart,omitempty"`
+	BucketEnd   string             `protobuf:"bytes,2,opt,name=bucketEnd,proto3" json:"bucketEnd,omitempty"`
+	Data        []*TopCommitAuthor `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TopCommitAuthorBucket) Reset() {
+	*x = TopCommitAuthorBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopCommitAuthorBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopCommitAuthorBucket) ProtoMessage() {}
+
+func (x *TopCommitAuthorBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopCommitAuthorBucket.ProtoReflect.Descriptor instead.
+func (*TopCommitAuthorBucket) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TopCommitAuthorBucket) GetBucketStart() string {
+	if x != nil {
+		return x.BucketStart
+	}
+	return ""
+}
+
+func (x *TopCommitAuthorBucket) GetBucketEnd() string {
+	if x != nil {
+		return x.BucketEnd
+	}
+	return ""
+}
+
+func (x *TopCommitAuthorBucket) GetData() []*TopCommitAuthor {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DateWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromDate string `protobuf:"bytes,1,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate   string `protobuf:"bytes,2,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *DateWindow) Reset() {
+	*x = DateWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateWindow) ProtoMessage() {}
+
+func (x *DateWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateWindow.ProtoReflect.Descriptor instead.
+func (*DateWindow) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DateWindow) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *DateWindow) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type CommitFilterParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page      int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OwnerName string `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName  string `protobuf:"bytes,4,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	FromDate  string `protobuf:"bytes,5,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate    string `protobuf:"bytes,6,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	Branch    string `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+	// Only match commits that changed a file under this path prefix.
+	Path string `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+	// Only match commits carrying every one of these trailer key/value pairs.
+	Trailers map[string]string `protobuf:"bytes,9,rep,name=trailers,proto3" json:"trailers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Bots matched by the author aliases config are excluded from aggregations unless this is set.
+	IncludeBots bool `protobuf:"varint,10,opt,name=includeBots,proto3" json:"includeBots,omitempty"`
+	// The fields below only apply to ListTopCommitAuthor.
+	// Groups the leaderboard into buckets, either week or month.
+	GroupBy string `protobuf:"bytes,11,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	// Ranks the last windowDays days against the windowDays days before them.
+	WindowDays   int64 `protobuf:"varint,12,opt,name=windowDays,proto3" json:"windowDays,omitempty"`
+	IncludeChurn bool  `protobuf:"varint,13,opt,name=includeChurn,proto3" json:"includeChurn,omitempty"`
+	// Matches commits of any of these repos of the owner, takes precedence over repo_name.
+	RepoNames []string `protobuf:"bytes,14,rep,name=repoNames,proto3" json:"repoNames,omitempty"`
+	// Conventional commit filters.
+	Type     string `protobuf:"bytes,15,opt,name=type,proto3" json:"type,omitempty"`
+	Scope    string `protobuf:"bytes,16,opt,name=scope,proto3" json:"scope,omitempty"`
+	Breaking *bool  `protobuf:"varint,17,opt,name=breaking,proto3,oneof" json:"breaking,omitempty"`
+}
+
+func (x *CommitFilterParams) Reset() {
+	*x = CommitFilterParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitFilterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitFilterParams) ProtoMessage() {}
+
+func (x *CommitFilterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitFilterParams.ProtoReflect.Descriptor instead.
+func (*CommitFilterParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CommitFilterParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CommitFilterParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CommitFilterParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetTrailers() map[string]string {
+	if x != nil {
+		return x.Trailers
+	}
+	return nil
+}
+
+func (x *CommitFilterParams) GetIncludeBots() bool {
+	if x != nil {
+		return x.IncludeBots
+	}
+	return false
+}
+
+func (x *CommitFilterParams) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetWindowDays() int64 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *CommitFilterParams) GetIncludeChurn() bool {
+	if x != nil {
+		return x.IncludeChurn
+	}
+	return false
+}
+
+func (x *CommitFilterParams) GetRepoNames() []string {
+	if x != nil {
+		return x.RepoNames
+	}
+	return nil
+}
+
+func (x *CommitFilterParams) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CommitFilterParams) GetBreaking() bool {
+	if x != nil && x.Breaking != nil {
+		return *x.Breaking
+	}
+	return false
+}
+
+type CommitTypeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	CommitsCount  int64  `protobuf:"varint,2,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+	BreakingCount int64  `protobuf:"varint,3,opt,name=breakingCount,proto3" json:"breakingCount,omitempty"`
+}
+
+func (x *CommitTypeCount) Reset() {
+	*x = CommitTypeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitTypeCount) ProtoMessage() {}
+
+func (x *CommitTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitTypeCount.ProtoReflect.Descriptor instead.
+func (*CommitTypeCount) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CommitTypeCount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CommitTypeCount) GetCommitsCount() int64 {
+	if x != nil {
+		return x.CommitsCount
+	}
+	return 0
+}
+
+func (x *CommitTypeCount) GetBreakingCount() int64 {
+	if x != nil {
+		return x.BreakingCount
+	}
+	return 0
+}
+
+type CommitTypeBreakdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*CommitTypeCount `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CommitTypeBreakdownResponse) Reset() {
+	*x = CommitTypeBreakdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitTypeBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitTypeBreakdownResponse) ProtoMessage() {}
+
+func (x *CommitTypeBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitTypeBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*CommitTypeBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CommitTypeBreakdownResponse) GetData() []*CommitTypeCount {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TopReviewer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewer     string `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	ReviewsCount int64  `protobuf:"varint,2,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`
+}
+
+func (x *TopReviewer) Reset() {
+	*x = TopReviewer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopReviewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopReviewer) ProtoMessage() {}
+
+func (x *TopReviewer) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopReviewer.ProtoReflect.Descriptor instead.
+func (*TopReviewer) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TopReviewer) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *TopReviewer) GetReviewsCount() int64 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+type ListTopReviewerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*TopReviewer `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListTopReviewerResponse) Reset() {
+	*x = ListTopReviewerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopReviewerResponse) ProtoMessage() {}
+
+func (x *ListTopReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ListTopReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTopReviewerResponse) GetData() []*TopReviewer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommitByOwnerAndShaParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Sha       string `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+}
+
+func (x *CommitByOwnerAndShaParams) Reset() {
+	*x = CommitByOwnerAndShaParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitByOwnerAndShaParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitByOwnerAndShaParams) ProtoMessage() {}
+
+func (x *CommitByOwnerAndShaParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitByOwnerAndShaParams.ProtoReflect.Descriptor instead.
+func (*CommitByOwnerAndShaParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CommitByOwnerAndShaParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitByOwnerAndShaParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitByOwnerAndShaParams) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int64 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HealthCheckResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type ListCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Commit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListCommitResponse) Reset() {
+	*x = ListCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitResponse) ProtoMessage() {}
+
+func (x *ListCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitResponse.ProtoReflect.Descriptor instead.
+func (*ListCommitResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCommitResponse) GetData() []*Commit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListTopCommitAuthorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data           []*TopCommitAuthor       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Buckets        []*TopCommitAuthorBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	CurrentWindow  *DateWindow              `protobuf:"bytes,3,opt,name=currentWindow,proto3" json:"currentWindow,omitempty"`
+	PreviousWindow *DateWindow              `protobuf:"bytes,4,opt,name=previousWindow,proto3" json:"previousWindow,omitempty"`
+}
+
+func (x *ListTopCommitAuthorResponse) Reset() {
+	*x = ListTopCommitAuthorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopCommitAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopCommitAuthorResponse) ProtoMessage() {}
+
+func (x *ListTopCommitAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopCommitAuthorResponse.ProtoReflect.Descriptor instead.
+func (*ListTopCommitAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListTopCommitAuthorResponse) GetData() []*TopCommitAuthor {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListTopCommitAuthorResponse) GetBuckets() []*TopCommitAuthorBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *ListTopCommitAuthorResponse) GetCurrentWindow() *DateWindow {
+	if x != nil {
+		return x.CurrentWindow
+	}
+	return nil
+}
+
+func (x *ListTopCommitAuthorResponse) GetPreviousWindow() *DateWindow {
+	if x != nil {
+		return x.PreviousWindow
+	}
+	return nil
+}
+
+type MonitorRepositoryCommitsConfigParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName       string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName        string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	FromDate        string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate          string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	DurationInHours int64  `protobuf:"varint,5,opt,name=durationInHours,proto3" json:"durationInHours,omitempty"`
+	// Branch names or glob patterns (e.g. release/*), defaults to the repo's default branch when empty.
+	Branches []string `protobuf:"bytes,6,rep,name=branches,proto3" json:"branches,omitempty"`
+	// Fetch each commit individually to capture its diff stats and changed files.
+	EnrichDiffStats bool `protobuf:"varint,7,opt,name=enrichDiffStats,proto3" json:"enrichDiffStats,omitempty"`
+	// URLs newly mirrored commits are POSTed to.
+	Webhooks []string `protobuf:"bytes,8,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) Reset() {
+	*x = MonitorRepositoryCommitsConfigParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorRepositoryCommitsConfigParams) ProtoMessage() {}
+
+func (x *MonitorRepositoryCommitsConfigParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorRepositoryCommitsConfigParams.ProtoReflect.Descriptor instead.
+func (*MonitorRepositoryCommitsConfigParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetDurationInHours() int64 {
+	if x != nil {
+		return x.DurationInHours
+	}
+	return 0
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetEnrichDiffStats() bool {
+	if x != nil {
+		return x.EnrichDiffStats
+	}
+	return false
+}
+
+func (x *MonitorRepositoryCommitsConfigParams) GetWebhooks() []string {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// The monitoring configs of every repo whose commits are being mirrored.
+type ListMonitoredRepositoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*MonitorRepositoryCommitsConfigParams `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListMonitoredRepositoriesResponse) Reset() {
+	*x = ListMonitoredRepositoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMonitoredRepositoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonitoredRepositoriesResponse) ProtoMessage() {}
+
+func (x *ListMonitoredRepositoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonitoredRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListMonitoredRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMonitoredRepositoriesResponse) GetData() []*MonitorRepositoryCommitsConfigParams {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Monitors every repository of an owner, including the ones created after monitoring started.
+type MonitorOwnerRepositoriesConfigParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName       string   `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	FromDate        string   `protobuf:"bytes,2,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate          string   `protobuf:"bytes,3,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	DurationInHours int64    `protobuf:"varint,4,opt,name=durationInHours,proto3" json:"durationInHours,omitempty"`
+	Branches        []string `protobuf:"bytes,5,rep,name=branches,proto3" json:"branches,omitempty"`
+	EnrichDiffStats bool     `protobuf:"varint,6,opt,name=enrichDiffStats,proto3" json:"enrichDiffStats,omitempty"`
+	IncludeForks    bool     `protobuf:"varint,7,opt,name=includeForks,proto3" json:"includeForks,omitempty"`
+	IncludeArchived bool     `protobuf:"varint,8,opt,name=includeArchived,proto3" json:"includeArchived,omitempty"`
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) Reset() {
+	*x = MonitorOwnerRepositoriesConfigParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorOwnerRepositoriesConfigParams) ProtoMessage() {}
+
+func (x *MonitorOwnerRepositoriesConfigParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorOwnerRepositoriesConfigParams.ProtoReflect.Descriptor instead.
+func (*MonitorOwnerRepositoriesConfigParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetDurationInHours() int64 {
+	if x != nil {
+		return x.DurationInHours
+	}
+	return 0
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetEnrichDiffStats() bool {
+	if x != nil {
+		return x.EnrichDiffStats
+	}
+	return false
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetIncludeForks() bool {
+	if x != nil {
+		return x.IncludeForks
+	}
+	return false
+}
+
+func (x *MonitorOwnerRepositoriesConfigParams) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type StopMonitoringOwnerRepositoriesParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+}
+
+func (x *StopMonitoringOwnerRepositoriesParams) Reset() {
+	*x = StopMonitoringOwnerRepositoriesParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopMonitoringOwnerRepositoriesParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMonitoringOwnerRepositoriesParams) ProtoMessage() {}
+
+func (x *StopMonitoringOwnerRepositoriesParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMonitoringOwnerRepositoriesParams.ProtoReflect.Descriptor instead.
+func (*StopMonitoringOwnerRepositoriesParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StopMonitoringOwnerRepositoriesParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+// Moves the mirrored data of a repo to its new owner and repo name after a rename or transfer.
+type RenameRepositoryParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromOwnerName string `protobuf:"bytes,1,opt,name=fromOwnerName,proto3" json:"fromOwnerName,omitempty"`
+	FromRepoName  string `protobuf:"bytes,2,opt,name=fromRepoName,proto3" json:"fromRepoName,omitempty"`
+	ToOwnerName   string `protobuf:"bytes,3,opt,name=toOwnerName,proto3" json:"toOwnerName,omitempty"`
+	ToRepoName    string `protobuf:"bytes,4,opt,name=toRepoName,proto3" json:"toRepoName,omitempty"`
+}
+
+func (x *RenameRepositoryParams) Reset() {
+	*x = RenameRepositoryParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameRepositoryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRepositoryParams) ProtoMessage() {}
+
+func (x *RenameRepositoryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRepositoryParams.ProtoReflect.Descriptor instead.
+func (*RenameRepositoryParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RenameRepositoryParams) GetFromOwnerName() string {
+	if x != nil {
+		return x.FromOwnerName
+	}
+	return ""
+}
+
+func (x *RenameRepositoryParams) GetFromRepoName() string {
+	if x != nil {
+		return x.FromRepoName
+	}
+	return ""
+}
+
+func (x *RenameRepositoryParams) GetToOwnerName() string {
+	if x != nil {
+		return x.ToOwnerName
+	}
+	return ""
+}
+
+func (x *RenameRepositoryParams) GetToRepoName() string {
+	if x != nil {
+		return x.ToRepoName
+	}
+	return ""
+}
+
+type StopMonitoringRepositoryCommitParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	// Also deletes the mirrored commits, tags and releases of the repo.
+	PurgeCommits bool `protobuf:"varint,3,opt,name=purgeCommits,proto3" json:"purgeCommits,omitempty"`
+}
+
+func (x *StopMonitoringRepositoryCommitParams) Reset() {
+	*x = StopMonitoringRepositoryCommitParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopMonitoringRepositoryCommitParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMonitoringRepositoryCommitParams) ProtoMessage() {}
+
+func (x *StopMonitoringRepositoryCommitParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMonitoringRepositoryCommitParams.ProtoReflect.Descriptor instead.
+func (*StopMonitoringRepositoryCommitParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StopMonitoringRepositoryCommitParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *StopMonitoringRepositoryCommitParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *StopMonitoringRepositoryCommitParams) GetPurgeCommits() bool {
+	if x != nil {
+		return x.PurgeCommits
+	}
+	return false
+}
+
+// A git tag mirrored by the commit monitor, linked to the commit it points to.
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerName string `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	CommitSha string `protobuf:"bytes,4,opt,name=commitSha,proto3" json:"commitSha,omitempty"`
+	Date      string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Message   string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *Tag) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *Tag) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *Tag) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Tag) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A GitHub release mirrored by the commit monitor.
+type Release struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerName   string `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName    string `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	TagName     string `protobuf:"bytes,4,opt,name=tagName,proto3" json:"tagName,omitempty"`
+	Name        string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Body        string `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	CommitSha   string `protobuf:"bytes,7,opt,name=commitSha,proto3" json:"commitSha,omitempty"`
+	Author      string `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	Url         string `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+	Draft       bool   `protobuf:"varint,10,opt,name=draft,proto3" json:"draft,omitempty"`
+	Prerelease  bool   `protobuf:"varint,11,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	CreatedAt   string `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	PublishedAt string `protobuf:"bytes,13,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Release) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Release) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *Release) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *Release) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *Release) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Release) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Release) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *Release) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Release) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Release) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *Release) GetPrerelease() bool {
+	if x != nil {
+		return x.Prerelease
+	}
+	return false
+}
+
+func (x *Release) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Release) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+type RepoRefsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Page      int64  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *RepoRefsParams) Reset() {
+	*x = RepoRefsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoRefsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoRefsParams) ProtoMessage() {}
+
+func (x *RepoRefsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoRefsParams.ProtoReflect.Descriptor instead.
+func (*RepoRefsParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RepoRefsParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RepoRefsParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RepoRefsParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RepoRefsParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Tag `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTagsResponse) GetData() []*Tag {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListReleasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Release `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListReleasesResponse) Reset() {
+	*x = ListReleasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleasesResponse) ProtoMessage() {}
+
+func (x *ListReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListReleasesResponse) GetData() []*Release {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommitsBetweenTagsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	FromTag   string `protobuf:"bytes,3,opt,name=fromTag,proto3" json:"fromTag,omitempty"`
+	ToTag     string `protobuf:"bytes,4,opt,name=toTag,proto3" json:"toTag,omitempty"`
+}
+
+func (x *CommitsBetweenTagsParams) Reset() {
+	*x = CommitsBetweenTagsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitsBetweenTagsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitsBetweenTagsParams) ProtoMessage() {}
+
+func (x *CommitsBetweenTagsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitsBetweenTagsParams.ProtoReflect.Descriptor instead.
+func (*CommitsBetweenTagsParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CommitsBetweenTagsParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitsBetweenTagsParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitsBetweenTagsParams) GetFromTag() string {
+	if x != nil {
+		return x.FromTag
+	}
+	return ""
+}
+
+func (x *CommitsBetweenTagsParams) GetToTag() string {
+	if x != nil {
+		return x.ToTag
+	}
+	return ""
+}
+
+// Commits reachable from `to` but not from `from`, following parentCommitIDs. Both ends accept a
+// commit sha, a tag name or a date (YYYY-MM-DD, resolved to the last commit on or before that day).
+type CommitRangeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *CommitRangeParams) Reset() {
+	*x = CommitRangeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitRangeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitRangeParams) ProtoMessage() {}
+
+func (x *CommitRangeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitRangeParams.ProtoReflect.Descriptor instead.
+func (*CommitRangeParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CommitRangeParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitRangeParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitRangeParams) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CommitRangeParams) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Walks parentCommitIDs from sha, served from the mirrored commits only.
+type CommitGraphParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Sha       string `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
+	// Maximum number of generations to walk, 0 walks the whole mirrored history.
+	Depth int64 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Only follow the first parent of merge commits.
+	FirstParent bool  `protobuf:"varint,5,opt,name=firstParent,proto3" json:"firstParent,omitempty"`
+	Limit       int64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *CommitGraphParams) Reset() {
+	*x = CommitGraphParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphParams) ProtoMessage() {}
+
+func (x *CommitGraphParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphParams.ProtoReflect.Descriptor instead.
+func (*CommitGraphParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CommitGraphParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitGraphParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitGraphParams) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *CommitGraphParams) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CommitGraphParams) GetFirstParent() bool {
+	if x != nil {
+		return x.FirstParent
+	}
+	return false
+}
+
+func (x *CommitGraphParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CommitPairParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	// IsAncestor checks whether shaA is an ancestor of shaB, order doesn't matter for GetMergeBase.
+	ShaA string `protobuf:"bytes,3,opt,name=shaA,proto3" json:"shaA,omitempty"`
+	ShaB string `protobuf:"bytes,4,opt,name=shaB,proto3" json:"shaB,omitempty"`
+}
+
+func (x *CommitPairParams) Reset() {
+	*x = CommitPairParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitPairParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitPairParams) ProtoMessage() {}
+
+func (x *CommitPairParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitPairParams.ProtoReflect.Descriptor instead.
+func (*CommitPairParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CommitPairParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CommitPairParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitPairParams) GetShaA() string {
+	if x != nil {
+		return x.ShaA
+	}
+	return ""
+}
+
+func (x *CommitPairParams) GetShaB() string {
+	if x != nil {
+		return x.ShaB
+	}
+	return ""
+}
+
+type IsAncestorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAncestor bool `protobuf:"varint,1,opt,name=isAncestor,proto3" json:"isAncestor,omitempty"`
+	// Number of generations between the two commits, when shaA is an ancestor of shaB.
+	Distance int64 `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *IsAncestorResponse) Reset() {
+	*x = IsAncestorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsAncestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAncestorResponse) ProtoMessage() {}
+
+func (x *IsAncestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAncestorResponse.ProtoReflect.Descriptor instead.
+func (*IsAncestorResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *IsAncestorResponse) GetIsAncestor() bool {
+	if x != nil {
+		return x.IsAncestor
+	}
+	return false
+}
+
+func (x *IsAncestorResponse) GetDistance() int64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// Full-text search over commit messages, quoted terms are matched as phrases.
+type SearchCommitsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query     string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	OwnerName string `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Author    string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	FromDate  string `protobuf:"bytes,5,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate    string `protobuf:"bytes,6,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	Page      int64  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int64  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchCommitsParams) Reset() {
+	*x = SearchCommitsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCommitsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCommitsParams) ProtoMessage() {}
+
+func (x *SearchCommitsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCommitsParams.ProtoReflect.Descriptor instead.
+func (*SearchCommitsParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchCommitsParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCommitsParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *SearchCommitsParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *SearchCommitsParams) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchCommitsParams) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *SearchCommitsParams) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *SearchCommitsParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchCommitsParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CommitSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Excerpt of the commit message with matched terms wrapped in <mark></mark>.
+	Snippet string  `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Rank    float64 `protobuf:"fixed64,3,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *CommitSearchResult) Reset() {
+	*x = CommitSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitSearchResult) ProtoMessage() {}
+
+func (x *CommitSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitSearchResult.ProtoReflect.Descriptor instead.
+func (*CommitSearchResult) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CommitSearchResult) GetCommit() *Commit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *CommitSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *CommitSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SearchCommitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*CommitSearchResult `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SearchCommitsResponse) Reset() {
+	*x = SearchCommitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCommitsResponse) ProtoMessage() {}
+
+func (x *SearchCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCommitsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchCommitsResponse) GetData() []*CommitSearchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Maps the names, emails and logins an author commits with onto one identity.
+type AuthorAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email  string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Login  string   `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	Names  []string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	Emails []string `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	Logins []string `protobuf:"bytes,6,rep,name=logins,proto3" json:"logins,omitempty"`
+}
+
+func (x *AuthorAlias) Reset() {
+	*x = AuthorAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorAlias) ProtoMessage() {}
+
+func (x *AuthorAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorAlias.ProtoReflect.Descriptor instead.
+func (*AuthorAlias) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AuthorAlias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthorAlias) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthorAlias) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *AuthorAlias) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *AuthorAlias) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *AuthorAlias) GetLogins() []string {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+// Author identity rules of a repo, or of every repo of an owner when repoName is empty.
+type AuthorAliasesConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string         `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string         `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Aliases   []*AuthorAlias `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// Glob patterns matched against author names, emails and logins (e.g. *[bot], *-autoroll@*).
+	BotPatterns []string `protobuf:"bytes,4,rep,name=botPatterns,proto3" json:"botPatterns,omitempty"`
+}
+
+func (x *AuthorAliasesConfig) Reset() {
+	*x = AuthorAliasesConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorAliasesConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorAliasesConfig) ProtoMessage() {}
+
+func (x *AuthorAliasesConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorAliasesConfig.ProtoReflect.Descriptor instead.
+func (*AuthorAliasesConfig) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuthorAliasesConfig) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *AuthorAliasesConfig) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *AuthorAliasesConfig) GetAliases() []*AuthorAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *AuthorAliasesConfig) GetBotPatterns() []string {
+	if x != nil {
+		return x.BotPatterns
+	}
+	return nil
+}
+
+type AuthorAliasesScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+}
+
+func (x *AuthorAliasesScope) Reset() {
+	*x = AuthorAliasesScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorAliasesScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorAliasesScope) ProtoMessage() {}
+
+func (x *AuthorAliasesScope) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorAliasesScope.ProtoReflect.Descriptor instead.
+func (*AuthorAliasesScope) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AuthorAliasesScope) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *AuthorAliasesScope) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+type CommitActivityParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *CommitFilterParams `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Bucket size, one of day, week or month.
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Optionally breaks every bucket down by author or branch.
+	Breakdown string `protobuf:"bytes,3,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+}
+
+func (x *CommitActivityParams) Reset() {
+	*x = CommitActivityParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitActivityParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitActivityParams) ProtoMessage() {}
+
+func (x *CommitActivityParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitActivityParams.ProtoReflect.Descriptor instead.
+func (*CommitActivityParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CommitActivityParams) GetFilter() *CommitFilterParams {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CommitActivityParams) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *CommitActivityParams) GetBreakdown() string {
+	if x != nil {
+		return x.Breakdown
+	}
+	return ""
+}
+
+type ActivityBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketStart  string `protobuf:"bytes,1,opt,name=bucketStart,proto3" json:"bucketStart,omitempty"`
+	CommitsCount int64  `protobuf:"varint,2,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+	// Commits per author or branch, only set when a breakdown is requested.
+	Breakdown map[string]int64 `protobuf:"bytes,3,rep,name=breakdown,proto3" json:"breakdown,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ActivityBucket) Reset() {
+	*x = ActivityBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityBucket) ProtoMessage() {}
+
+func (x *ActivityBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityBucket.ProtoReflect.Descriptor instead.
+func (*ActivityBucket) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ActivityBucket) GetBucketStart() string {
+	if x != nil {
+		return x.BucketStart
+	}
+	return ""
+}
+
+func (x *ActivityBucket) GetCommitsCount() int64 {
+	if x != nil {
+		return x.CommitsCount
+	}
+	return 0
+}
+
+func (x *ActivityBucket) GetBreakdown() map[string]int64 {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+// Commits made on a weekday (0 is Sunday) at an hour of the day (0-23, UTC).
+type PunchCardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weekday      int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Hour         int32 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	CommitsCount int64 `protobuf:"varint,3,opt,name=commitsCount,proto3" json:"commitsCount,omitempty"`
+}
+
+func (x *PunchCardEntry) Reset() {
+	*x = PunchCardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PunchCardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunchCardEntry) ProtoMessage() {}
+
+func (x *PunchCardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunchCardEntry.ProtoReflect.Descriptor instead.
+func (*PunchCardEntry) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PunchCardEntry) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *PunchCardEntry) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *PunchCardEntry) GetCommitsCount() int64 {
+	if x != nil {
+		return x.CommitsCount
+	}
+	return 0
+}
+
+type CommitActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets   []*ActivityBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	PunchCard []*PunchCardEntry `protobuf:"bytes,2,rep,name=punchCard,proto3" json:"punchCard,omitempty"`
+}
+
+func (x *CommitActivityResponse) Reset() {
+	*x = CommitActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitActivityResponse) ProtoMessage() {}
+
+func (x *CommitActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitActivityResponse.ProtoReflect.Descriptor instead.
+func (*CommitActivityResponse) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CommitActivityResponse) GetBuckets() []*ActivityBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *CommitActivityResponse) GetPunchCard() []*PunchCardEntry {
+	if x != nil {
+		return x.PunchCard
+	}
+	return nil
+}
+
+// Extracts issue keys out of commit messages, e.g. pattern `Bug: (\d+)` with urlTemplate
+// `https://issues.chromium.org/issues/{key}`. The first capture group of the pattern is the key.
+type IssuePattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pattern     string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	UrlTemplate string `protobuf:"bytes,3,opt,name=urlTemplate,proto3" json:"urlTemplate,omitempty"`
+}
+
+func (x *IssuePattern) Reset() {
+	*x = IssuePattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuePattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePattern) ProtoMessage() {}
+
+func (x *IssuePattern) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePattern.ProtoReflect.Descriptor instead.
+func (*IssuePattern) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *IssuePattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssuePattern) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *IssuePattern) GetUrlTemplate() string {
+	if x != nil {
+		return x.UrlTemplate
+	}
+	return ""
+}
+
+// Issue patterns of a repo, or of every repo of an owner when repoName is empty.
+type IssuePatternsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string          `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string          `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Patterns  []*IssuePattern `protobuf:"bytes,3,rep,name=patterns,proto3" json:"patterns,omitempty"`
+}
+
+func (x *IssuePatternsConfig) Reset() {
+	*x = IssuePatternsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuePatternsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePatternsConfig) ProtoMessage() {}
+
+func (x *IssuePatternsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePatternsConfig.ProtoReflect.Descriptor instead.
+func (*IssuePatternsConfig) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IssuePatternsConfig) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *IssuePatternsConfig) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *IssuePatternsConfig) GetPatterns() []*IssuePattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+type RepoScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+}
+
+func (x *RepoScope) Reset() {
+	*x = RepoScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoScope) ProtoMessage() {}
+
+func (x *RepoScope) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoScope.ProtoReflect.Descriptor instead.
+func (*RepoScope) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RepoScope) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RepoScope) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+type IssueCommitsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	OwnerName string `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,3,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Page      int64  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int64  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *IssueCommitsParams) Reset() {
+	*x = IssueCommitsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commits_commits_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueCommitsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCommitsParams) ProtoMessage() {}
+
+func (x *IssueCommitsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_commits_commits_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCommitsParams.ProtoReflect.Descriptor instead.
+func (*IssueCommitsParams) Descriptor() ([]byte, []int) {
+	return file_commits_commits_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IssueCommitsParams) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IssueCommitsParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *IssueCommitsParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *IssueCommitsParams) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *IssueCommitsParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_commits_commits_proto protoreflect.FileDescriptor
+
+var file_commits_commits_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0xdc, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3f,
+	0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x64, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x4e, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x7c, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x31, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5f, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x91, 0x03, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52,
+	0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x2c, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x0a, 0x44,
+	0x61, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd4, 0x04,
+	0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x6f, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42,
+	0x6f, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x68, 0x75, 0x72, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x68, 0x75, 0x72,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x22, 0x6f, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x4d, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x43, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x22,
+	0x29, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfd, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
+	0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54,
+	0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x39, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xa0, 0x02, 0x0a, 0x24, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x72,
+	0x69, 0x63, 0x68, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x66, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xb6, 0x02, 0x0a, 0x24, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x44, 0x69, 0x66, 0x66, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x72, 0x69,
+	0x63, 0x68, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x25, 0x53, 0x74, 0x6f,
+	0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xa4, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66,
+	0x72, 0x6f, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x52,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x24, 0x53, 0x74, 0x6f, 0x70,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75,
+	0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x70, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x9f,
+	0x01, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xd3, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x53, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x74, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x66, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x34, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x3c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x84, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x54,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x61,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x54, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x54, 0x61, 0x67, 0x22, 0x71, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x74, 0x0a, 0x10, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x69, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x41,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x68, 0x61, 0x41, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x68, 0x61, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x68, 0x61, 0x42,
+	0x22, 0x50, 0x0a, 0x12, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x41, 0x6e, 0x63, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x6e,
+	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x6b, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x48, 0x0a,
+	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0xa1, 0x01,
+	0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x22, 0x4e, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x62, 0x0a, 0x0e, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x43,
+	0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x75, 0x6e, 0x63,
+	0x68, 0x43, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x22,
+	0x5e, 0x0a, 0x0c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x72, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x75, 0x72, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x13, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x22, 0x45, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x32, 0x86, 0x12, 0x0a, 0x15, 0x47, 0x69, 0x74,
+	0x42, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x41, 0x6e, 0x64, 0x53, 0x48, 0x41, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41,
+	0x6e, 0x64, 0x53, 0x68, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x6f, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1f,
+	0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x66, 0x73, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x65, 0x66, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x54, 0x61, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x54, 0x61,
+	0x67, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x6f, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x10, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1f, 0x53,
+	0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x53, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x50, 0x61, 0x69, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0a, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61,
+	0x69, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x12, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2e, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_commits_commits_proto_rawDescOnce sync.Once
+	file_commits_commits_proto_rawDescData = file_commits_commits_proto_rawDesc
+)
+
+func file_commits_commits_proto_rawDescGZIP() []byte {
+	file_commits_commits_proto_rawDescOnce.Do(func() {
+		file_commits_commits_proto_rawDescData = protoimpl.X.CompressGZIP(file_commits_commits_proto_rawDescData)
+	})
+	return file_commits_commits_proto_rawDescData
+}
+
+var file_commits_commits_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_commits_commits_proto_goTypes = []interface{}{
+	(*Void)(nil),                                  // 0: commits.Void
+	(*Commit)(nil),                                // 1: commits.Commit
+	(*CommitMeta)(nil),                            // 2: commits.CommitMeta
+	(*CommitIdentity)(nil),                        // 3: commits.CommitIdentity
+	(*CommitVerification)(nil),                    // 4: commits.CommitVerification
+	(*IssueReference)(nil),                        // 5: commits.IssueReference
+	(*ConventionalCommit)(nil),                    // 6: commits.ConventionalCommit
+	(*Trailer)(nil),                               // 7: commits.Trailer
+	(*CommitStats)(nil),                           // 8: commits.CommitStats
+	(*CommitFile)(nil),                            // 9: commits.CommitFile
+	(*TopCommitAuthor)(nil),                       // 10: commits.TopCommitAuthor
+	(*TopCommitAuthorBucket)(nil),                 // 11: commits.TopCommitAuthorBucket
+	(*DateWindow)(nil),                            // 12: commits.DateWindow
+	(*CommitFilterParams)(nil),                    // 13: commits.CommitFilterParams
+	(*CommitTypeCount)(nil),                       // 14: commits.CommitTypeCount
+	(*CommitTypeBreakdownResponse)(nil),           // 15: commits.CommitTypeBreakdownResponse
+	(*TopReviewer)(nil),                           // 16: commits.TopReviewer
+	(*ListTopReviewerResponse)(nil),               // 17: commits.ListTopReviewerResponse
+	(*CommitByOwnerAndShaParams)(nil),             // 18: commits.CommitByOwnerAndShaParams
+	(*HealthCheckResponse)(nil),                   // 19: commits.HealthCheckResponse
+	(*ListCommitResponse)(nil),                    // 20: commits.ListCommitResponse
+	(*ListTopCommitAuthorResponse)(nil),           // 21: commits.ListTopCommitAuthorResponse
+	(*MonitorRepositoryCommitsConfigParams)(nil),  // 22: commits.MonitorRepositoryCommitsConfigParams
+	(*ListMonitoredRepositoriesResponse)(nil),     // 23: commits.ListMonitoredRepositoriesResponse
+	(*MonitorOwnerRepositoriesConfigParams)(nil),  // 24: commits.MonitorOwnerRepositoriesConfigParams
+	(*StopMonitoringOwnerRepositoriesParams)(nil), // 25: commits.StopMonitoringOwnerRepositoriesParams
+	(*RenameRepositoryParams)(nil),                // 26: commits.RenameRepositoryParams
+	(*StopMonitoringRepositoryCommitParams)(nil),  // 27: commits.StopMonitoringRepositoryCommitParams
+	(*Tag)(nil),                                   // 28: commits.Tag
+	(*Release)(nil),                               // 29: commits.Release
+	(*RepoRefsParams)(nil),                        // 30: commits.RepoRefsParams
+	(*ListTagsResponse)(nil),                      // 31: commits.ListTagsResponse
+	(*ListReleasesResponse)(nil),                  // 32: commits.ListReleasesResponse
+	(*CommitsBetweenTagsParams)(nil),              // 33: commits.CommitsBetweenTagsParams
+	(*CommitRangeParams)(nil),                     // 34: commits.CommitRangeParams
+	(*CommitGraphParams)(nil),                     // 35: commits.CommitGraphParams
+	(*CommitPairParams)(nil),                      // 36: commits.CommitPairParams
+	(*IsAncestorResponse)(nil),                    // 37: commits.IsAncestorResponse
+	(*SearchCommitsParams)(nil),                   // 38: commits.SearchCommitsParams
+	(*CommitSearchResult)(nil),                    // 39: commits.CommitSearchResult
+	(*SearchCommitsResponse)(nil),                 // 40: commits.SearchCommitsResponse
+	(*AuthorAlias)(nil),                           // 41: commits.AuthorAlias
+	(*AuthorAliasesConfig)(nil),                   // 42: commits.AuthorAliasesConfig
+	(*AuthorAliasesScope)(nil),                    // 43: commits.AuthorAliasesScope
+	(*CommitActivityParams)(nil),                  // 44: commits.CommitActivityParams
+	(*ActivityBucket)(nil),                        // 45: commits.ActivityBucket
+	(*PunchCardEntry)(nil),                        // 46: commits.PunchCardEntry
+	(*CommitActivityResponse)(nil),                // 47: commits.CommitActivityResponse
+	(*IssuePattern)(nil),                          // 48: commits.IssuePattern
+	(*IssuePatternsConfig)(nil),                   // 49: commits.IssuePatternsConfig
+	(*RepoScope)(nil),                             // 50: commits.RepoScope
+	(*IssueCommitsParams)(nil),                    // 51: commits.IssueCommitsParams
+	nil,                                           // 52: commits.CommitFilterParams.TrailersEntry
+	nil,                                           // 53: commits.ActivityBucket.BreakdownEntry
+}
+var file_commits_commits_proto_depIdxs = []int32{
+	8,  // 0: commits.Commit.stats:type_name -> commits.CommitStats
+	9,  // 1: commits.Commit.files:type_name -> commits.CommitFile
+	7,  // 2: commits.Commit.trailers:type_name -> commits.Trailer
+	6,  // 3: commits.Commit.conventional:type_name -> commits.ConventionalCommit
+	5,  // 4: commits.Commit.issues:type_name -> commits.IssueReference
+	2,  // 5: commits.Commit.meta:type_name -> commits.CommitMeta
+	3,  // 6: commits.CommitMeta.author:type_name -> commits.CommitIdentity
+	3,  // 7: commits.CommitMeta.committer:type_name -> commits.CommitIdentity
+	4,  // 8: commits.CommitMeta.verification:type_name -> commits.CommitVerification
+	10, // 9: commits.TopCommitAuthorBucket.data:type_name -> commits.TopCommitAuthor
+	52, // 10: commits.CommitFilterParams.trailers:type_name -> commits.CommitFilterParams.TrailersEntry
+	14, // 11: commits.CommitTypeBreakdownResponse.data:type_name -> commits.CommitTypeCount
+	16, // 12: commits.ListTopReviewerResponse.data:type_name -> commits.TopReviewer
+	1,  // 13: commits.ListCommitResponse.data:type_name -> commits.Commit
+	10, // 14: commits.ListTopCommitAuthorResponse.data:type_name -> commits.TopCommitAuthor
+	11, // 15: commits.ListTopCommitAuthorResponse.buckets:type_name -> commits.TopCommitAuthorBucket
+	12, // 16: commits.ListTopCommitAuthorResponse.currentWindow:type_name -> commits.DateWindow
+	12, // 17: commits.ListTopCommitAuthorResponse.previousWindow:type_name -> commits.DateWindow
+	22, // 18: commits.ListMonitoredRepositoriesResponse.data:type_name -> commits.MonitorRepositoryCommitsConfigParams
+	28, // 19: commits.ListTagsResponse.data:type_name -> commits.Tag
+	29, // 20: commits.ListReleasesResponse.data:type_name -> commits.Release
+	1,  // 21: commits.CommitSearchResult.commit:type_name -> commits.Commit
+	39, // 22: commits.SearchCommitsResponse.data:type_name -> commits.CommitSearchResult
+	41, // 23: commits.AuthorAliasesConfig.aliases:type_name -> commits.AuthorAlias
+	13, // 24: commits.CommitActivityParams.filter:type_name -> commits.CommitFilterParams
+	53, // 25: commits.ActivityBucket.breakdown:type_name -> commits.ActivityBucket.BreakdownEntry
+	45, // 26: commits.CommitActivityResponse.buckets:type_name -> commits.ActivityBucket
+	46, // 27: commits.CommitActivityResponse.punchCard:type_name -> commits.PunchCardEntry
+	48, // 28: commits.IssuePatternsConfig.patterns:type_name -> commits.IssuePattern
+	13, // 29: commits.GitBeamCommitsService.ListCommits:input_type -> commits.CommitFilterParams
+	18, // 30: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:input_type -> commits.CommitByOwnerAndShaParams
+	13, // 31: commits.GitBeamCommitsService.ListTopCommitAuthor:input_type -> commits.CommitFilterParams
+	0,  // 32: commits.GitBeamCommitsService.HealthCheck:input_type -> commits.Void
+	22, // 33: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:input_type -> commits.MonitorRepositoryCommitsConfigParams
+	27, // 34: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:input_type -> commits.StopMonitoringRepositoryCommitParams
+	30, // 35: commits.GitBeamCommitsService.ListTags:input_type -> commits.RepoRefsParams
+	30, // 36: commits.GitBeamCommitsService.ListReleases:input_type -> commits.RepoRefsParams
+	33, // 37: commits.GitBeamCommitsService.ListCommitsBetweenTags:input_type -> commits.CommitsBetweenTagsParams
+	38, // 38: commits.GitBeamCommitsService.SearchCommits:input_type -> commits.SearchCommitsParams
+	13, // 39: commits.GitBeamCommitsService.ListTopReviewers:input_type -> commits.CommitFilterParams
+	42, // 40: commits.GitBeamCommitsService.SetAuthorAliases:input_type -> commits.AuthorAliasesConfig
+	43, // 41: commits.GitBeamCommitsService.GetAuthorAliases:input_type -> commits.AuthorAliasesScope
+	44, // 42: commits.GitBeamCommitsService.GetCommitActivity:input_type -> commits.CommitActivityParams
+	24, // 43: commits.GitBeamCommitsService.StartMonitoringOwnerRepositories:input_type -> commits.MonitorOwnerRepositoriesConfigParams
+	25, // 44: commits.GitBeamCommitsService.StopMonitoringOwnerRepositories:input_type -> commits.StopMonitoringOwnerRepositoriesParams
+	13, // 45: commits.GitBeamCommitsService.ExportCommits:input_type -> commits.CommitFilterParams
+	34, // 46: commits.GitBeamCommitsService.ListCommitsBetweenRefs:input_type -> commits.CommitRangeParams
+	35, // 47: commits.GitBeamCommitsService.ListAncestors:input_type -> commits.CommitGraphParams
+	35, // 48: commits.GitBeamCommitsService.ListDescendants:input_type -> commits.CommitGraphParams
+	36, // 49: commits.GitBeamCommitsService.GetMergeBase:input_type -> commits.CommitPairParams
+	36, // 50: commits.GitBeamCommitsService.IsAncestor:input_type -> commits.CommitPairParams
+	13, // 51: commits.GitBeamCommitsService.ListCommitTypeBreakdown:input_type -> commits.CommitFilterParams
+	49, // 52: commits.GitBeamCommitsService.SetIssuePatterns:input_type -> commits.IssuePatternsConfig
+	50, // 53: commits.GitBeamCommitsService.GetIssuePatterns:input_type -> commits.RepoScope
+	51, // 54: commits.GitBeamCommitsService.ListIssueCommits:input_type -> commits.IssueCommitsParams
+	26, // 55: commits.GitBeamCommitsService.RenameRepository:input_type -> commits.RenameRepositoryParams
+	0,  // 56: commits.GitBeamCommitsService.ListMonitoredRepositories:input_type -> commits.Void
+	20, // 57: commits.GitBeamCommitsService.ListCommits:output_type -> commits.ListCommitResponse
+	1,  // 58: commits.GitBeamCommitsService.GetCommitByOwnerAndSHA:output_type -> commits.Commit
+	21, // 59: commits.GitBeamCommitsService.ListTopCommitAuthor:output_type -> commits.ListTopCommitAuthorResponse
+	19, // 60: commits.GitBeamCommitsService.HealthCheck:output_type -> commits.HealthCheckResponse
+	0,  // 61: commits.GitBeamCommitsService.StartMonitoringRepositoryCommits:output_type -> commits.Void
+	0,  // 62: commits.GitBeamCommitsService.StopMonitoringRepositoryCommits:output_type -> commits.Void
+	31, // 63: commits.GitBeamCommitsService.ListTags:output_type -> commits.ListTagsResponse
+	32, // 64: commits.GitBeamCommitsService.ListReleases:output_type -> commits.ListReleasesResponse
+	20, // 65: commits.GitBeamCommitsService.ListCommitsBetweenTags:output_type -> commits.ListCommitResponse
+	40, // 66: commits.GitBeamCommitsService.SearchCommits:output_type -> commits.SearchCommitsResponse
+	17, // 67: commits.GitBeamCommitsService.ListTopReviewers:output_type -> commits.ListTopReviewerResponse
+	42, // 68: commits.GitBeamCommitsService.SetAuthorAliases:output_type -> commits.AuthorAliasesConfig
+	42, // 69: commits.GitBeamCommitsService.GetAuthorAliases:output_type -> commits.AuthorAliasesConfig
+	47, // 70: commits.GitBeamCommitsService.GetCommitActivity:output_type -> commits.CommitActivityResponse
+	0,  // 71: commits.GitBeamCommitsService.StartMonitoringOwnerRepositories:output_type -> commits.Void
+	0,  // 72: commits.GitBeamCommitsService.StopMonitoringOwnerRepositories:output_type -> commits.Void
+	1,  // 73: commits.GitBeamCommitsService.ExportCommits:output_type -> commits.Commit
+	20, // 74: commits.GitBeamCommitsService.ListCommitsBetweenRefs:output_type -> commits.ListCommitResponse
+	20, // 75: commits.GitBeamCommitsService.ListAncestors:output_type -> commits.ListCommitResponse
+	20, // 76: commits.GitBeamCommitsService.ListDescendants:output_type -> commits.ListCommitResponse
+	1,  // 77: commits.GitBeamCommitsService.GetMergeBase:output_type -> commits.Commit
+	37, // 78: commits.GitBeamCommitsService.IsAncestor:output_type -> commits.IsAncestorResponse
+	15, // 79: commits.GitBeamCommitsService.ListCommitTypeBreakdown:output_type -> commits.CommitTypeBreakdownResponse
+	49, // 80: commits.GitBeamCommitsService.SetIssuePatterns:output_type -> commits.IssuePatternsConfig
+	49, // 81: commits.GitBeamCommitsService.GetIssuePatterns:output_type -> commits.IssuePatternsConfig
+	20, // 82: commits.GitBeamCommitsService.ListIssueCommits:output_type -> commits.ListCommitResponse
+	0,  // 83: commits.GitBeamCommitsService.RenameRepository:output_type -> commits.Void
+	23, // 84: commits.GitBeamCommitsService.ListMonitoredRepositories:output_type -> commits.ListMonitoredRepositoriesResponse
+	57, // [57:85] is the sub-list for method output_type
+	29, // [29:57] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_commits_commits_proto_init() }
+func file_commits_commits_proto_init() {
+	if File_commits_commits_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_commits_commits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Void); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConventionalCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopCommitAuthor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopCommitAuthorBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitFilterParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitTypeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitTypeBreakdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopReviewer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopReviewerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitByOwnerAndShaParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopCommitAuthorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorRepositoryCommitsConfigParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMonitoredRepositoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorOwnerRepositoriesConfigParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMonitoringOwnerRepositoriesParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameRepositoryParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMonitoringRepositoryCommitParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Release); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoRefsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReleasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitsBetweenTagsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitRangeParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitPairParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsAncestorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCommitsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorAliasesConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorAliasesScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitActivityParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunchCardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitActivityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuePattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuePatternsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commits_commits_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueCommitsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_commits_commits_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_commits_commits_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   54,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_commits_commits_proto_goTypes,
+		DependencyIndexes: file_commits_commits_proto_depIdxs,
+		MessageInfos:      file_commits_commits_proto_msgTypes,
+	}.Build()
+	File_commits_commits_proto = out.File
+	file_commits_commits_proto_rawDesc = nil
+	file_commits_commits_proto_goTypes = nil
+	file_commits_commits_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// GitBeamCommitsServiceClient is the client API for GitBeamCommitsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GitBeamCommitsServiceClient interface {
+	ListCommits(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	GetCommitByOwnerAndSHA(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*Commit, error)
+	ListTopCommitAuthor(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListTopCommitAuthorResponse, error)
+	HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error)
+	StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error)
+	ListTags(ctx context.Context, in *RepoRefsParams, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	ListReleases(ctx context.Context, in *RepoRefsParams, opts ...grpc.CallOption) (*ListReleasesResponse, error)
+	ListCommitsBetweenTags(ctx context.Context, in *CommitsBetweenTagsParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	SearchCommits(ctx context.Context, in *SearchCommitsParams, opts ...grpc.CallOption) (*SearchCommitsResponse, error)
+	ListTopReviewers(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListTopReviewerResponse, error)
+	SetAuthorAliases(ctx context.Context, in *AuthorAliasesConfig, opts ...grpc.CallOption) (*AuthorAliasesConfig, error)
+	GetAuthorAliases(ctx context.Context, in *AuthorAliasesScope, opts ...grpc.CallOption) (*AuthorAliasesConfig, error)
+	GetCommitActivity(ctx context.Context, in *CommitActivityParams, opts ...grpc.CallOption) (*CommitActivityResponse, error)
+	StartMonitoringOwnerRepositories(ctx context.Context, in *MonitorOwnerRepositoriesConfigParams, opts ...grpc.CallOption) (*Void, error)
+	StopMonitoringOwnerRepositories(ctx context.Context, in *StopMonitoringOwnerRepositoriesParams, opts ...grpc.CallOption) (*Void, error)
+	// Streams every commit matching the filters, page and limit are ignored.
+	ExportCommits(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (GitBeamCommitsService_ExportCommitsClient, error)
+	ListCommitsBetweenRefs(ctx context.Context, in *CommitRangeParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	ListAncestors(ctx context.Context, in *CommitGraphParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	ListDescendants(ctx context.Context, in *CommitGraphParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	GetMergeBase(ctx context.Context, in *CommitPairParams, opts ...grpc.CallOption) (*Commit, error)
+	IsAncestor(ctx context.Context, in *CommitPairParams, opts ...grpc.CallOption) (*IsAncestorResponse, error)
+	ListCommitTypeBreakdown(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*CommitTypeBreakdownResponse, error)
+	SetIssuePatterns(ctx context.Context, in *IssuePatternsConfig, opts ...grpc.CallOption) (*IssuePatternsConfig, error)
+	GetIssuePatterns(ctx context.Context, in *RepoScope, opts ...grpc.CallOption) (*IssuePatternsConfig, error)
+	ListIssueCommits(ctx context.Context, in *IssueCommitsParams, opts ...grpc.CallOption) (*ListCommitResponse, error)
+	RenameRepository(ctx context.Context, in *RenameRepositoryParams, opts ...grpc.CallOption) (*Void, error)
+	ListMonitoredRepositories(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListMonitoredRepositoriesResponse, error)
+}
+
+type gitBeamCommitsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGitBeamCommitsServiceClient(cc grpc.ClientConnInterface) GitBeamCommitsServiceClient {
+	return &gitBeamCommitsServiceClient{cc}
+}
+
+func (c *gitBeamCommitsServiceClient) ListCommits(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetCommitByOwnerAndSHA(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetCommitByOwnerAndSHA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListTopCommitAuthor(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListTopCommitAuthorResponse, error) {
+	out := new(ListTopCommitAuthorResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListTopCommitAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/HealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StartMonitoringRepositoryCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StopMonitoringRepositoryCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListTags(ctx context.Context, in *RepoRefsParams, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListReleases(ctx context.Context, in *RepoRefsParams, opts ...grpc.CallOption) (*ListReleasesResponse, error) {
+	out := new(ListReleasesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListReleases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListCommitsBetweenTags(ctx context.Context, in *CommitsBetweenTagsParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommitsBetweenTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) SearchCommits(ctx context.Context, in *SearchCommitsParams, opts ...grpc.CallOption) (*SearchCommitsResponse, error) {
+	out := new(SearchCommitsResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/SearchCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListTopReviewers(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListTopReviewerResponse, error) {
+	out := new(ListTopReviewerResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListTopReviewers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) SetAuthorAliases(ctx context.Context, in *AuthorAliasesConfig, opts ...grpc.CallOption) (*AuthorAliasesConfig, error) {
+	out := new(AuthorAliasesConfig)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/SetAuthorAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetAuthorAliases(ctx context.Context, in *AuthorAliasesScope, opts ...grpc.CallOption) (*AuthorAliasesConfig, error) {
+	out := new(AuthorAliasesConfig)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetAuthorAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetCommitActivity(ctx context.Context, in *CommitActivityParams, opts ...grpc.CallOption) (*CommitActivityResponse, error) {
+	out := new(CommitActivityResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetCommitActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) StartMonitoringOwnerRepositories(ctx context.Context, in *MonitorOwnerRepositoriesConfigParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StartMonitoringOwnerRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) StopMonitoringOwnerRepositories(ctx context.Context, in *StopMonitoringOwnerRepositoriesParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StopMonitoringOwnerRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ExportCommits(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (GitBeamCommitsService_ExportCommitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GitBeamCommitsService_serviceDesc.Streams[0], "/commits.GitBeamCommitsService/ExportCommits", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitBeamCommitsServiceExportCommitsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitBeamCommitsService_ExportCommitsClient interface {
+	Recv() (*Commit, error)
+	grpc.ClientStream
+}
+
+type gitBeamCommitsServiceExportCommitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitBeamCommitsServiceExportCommitsClient) Recv() (*Commit, error) {
+	m := new(Commit)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListCommitsBetweenRefs(ctx context.Context, in *CommitRangeParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommitsBetweenRefs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListAncestors(ctx context.Context, in *CommitGraphParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListAncestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListDescendants(ctx context.Context, in *CommitGraphParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListDescendants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetMergeBase(ctx context.Context, in *CommitPairParams, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetMergeBase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) IsAncestor(ctx context.Context, in *CommitPairParams, opts ...grpc.CallOption) (*IsAncestorResponse, error) {
+	out := new(IsAncestorResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/IsAncestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListCommitTypeBreakdown(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*CommitTypeBreakdownResponse, error) {
+	out := new(CommitTypeBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommitTypeBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) SetIssuePatterns(ctx context.Context, in *IssuePatternsConfig, opts ...grpc.CallOption) (*IssuePatternsConfig, error) {
+	out := new(IssuePatternsConfig)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/SetIssuePatterns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) GetIssuePatterns(ctx context.Context, in *RepoScope, opts ...grpc.CallOption) (*IssuePatternsConfig, error) {
+	out := new(IssuePatternsConfig)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetIssuePatterns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListIssueCommits(ctx context.Context, in *IssueCommitsParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
+	out := new(ListCommitResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListIssueCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) RenameRepository(ctx context.Context, in *RenameRepositoryParams, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/RenameRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamCommitsServiceClient) ListMonitoredRepositories(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListMonitoredRepositoriesResponse, error) {
+	out := new(ListMonitoredRepositoriesResponse)
+	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListMonitoredRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GitBeamCommitsServiceServer is the server API for GitBeamCommitsService service.
+type GitBeamCommitsServiceServer interface {
+	ListCommits(context.Context, *CommitFilterParams) (*ListCommitResponse, error)
+	GetCommitByOwnerAndSHA(context.Context, *CommitByOwnerAndShaParams) (*Commit, error)
+	ListTopCommitAuthor(context.Context, *CommitFilterParams) (*ListTopCommitAuthorResponse, error)
+	HealthCheck(context.Context, *Void) (*HealthCheckResponse, error)
+	StartMonitoringRepositoryCommits(context.Context, *MonitorRepositoryCommitsConfigParams) (*Void, error)
+	StopMonitoringRepositoryCommits(context.Context, *StopMonitoringRepositoryCommitParams) (*Void, error)
+	ListTags(context.Context, *RepoRefsParams) (*ListTagsResponse, error)
+	ListReleases(context.Context, *RepoRefsParams) (*ListReleasesResponse, error)
+	ListCommitsBetweenTags(context.Context, *CommitsBetweenTagsParams) (*ListCommitResponse, error)
+	SearchCommits(context.Context, *SearchCommitsParams) (*SearchCommitsResponse, error)
+	ListTopReviewers(context.Context, *CommitFilterParams) (*ListTopReviewerResponse, error)
+	SetAuthorAliases(context.Context, *AuthorAliasesConfig) (*AuthorAliasesConfig, error)
+	GetAuthorAliases(context.Context, *AuthorAliasesScope) (*AuthorAliasesConfig, error)
+	GetCommitActivity(context.Context, *CommitActivityParams) (*CommitActivityResponse, error)
+	StartMonitoringOwnerRepositories(context.Context, *MonitorOwnerRepositoriesConfigParams) (*Void, error)
+	StopMonitoringOwnerRepositories(context.Context, *StopMonitoringOwnerRepositoriesParams) (*Void, error)
+	// Streams every commit matching the filters, page and limit are ignored.
+	ExportCommits(*CommitFilterParams, GitBeamCommitsService_ExportCommitsServer) error
+	ListCommitsBetweenRefs(context.Context, *CommitRangeParams) (*ListCommitResponse, error)
+	ListAncestors(context.Context, *CommitGraphParams) (*ListCommitResponse, error)
+	ListDescendants(context.Context, *CommitGraphParams) (*ListCommitResponse, error)
+	GetMergeBase(context.Context, *CommitPairParams) (*Commit, error)
+	IsAncestor(context.Context, *CommitPairParams) (*IsAncestorResponse, error)
+	ListCommitTypeBreakdown(context.Context, *CommitFilterParams) (*CommitTypeBreakdownResponse, error)
+	SetIssuePatterns(context.Context, *IssuePatternsConfig) (*IssuePatternsConfig, error)
+	GetIssuePatterns(context.Context, *RepoScope) (*IssuePatternsConfig, error)
+	ListIssueCommits(context.Context, *IssueCommitsParams) (*ListCommitResponse, error)
+	RenameRepository(context.Context, *RenameRepositoryParams) (*Void, error)
+	ListMonitoredRepositories(context.Context, *Void) (*ListMonitoredRepositoriesResponse, error)
+}
+
+// UnimplementedGitBeamCommitsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedGitBeamCommitsServiceServer struct {
+}
+
+func (*UnimplementedGitBeamCommitsServiceServer) ListCommits(context.Context, *CommitFilterParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommits not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetCommitByOwnerAndSHA(context.Context, *CommitByOwnerAndShaParams) (*Commit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitByOwnerAndSHA not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListTopCommitAuthor(context.Context, *CommitFilterParams) (*ListTopCommitAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopCommitAuthor not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) HealthCheck(context.Context, *Void) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) StartMonitoringRepositoryCommits(context.Context, *MonitorRepositoryCommitsConfigParams) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMonitoringRepositoryCommits not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) StopMonitoringRepositoryCommits(context.Context, *StopMonitoringRepositoryCommitParams) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMonitoringRepositoryCommits not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListTags(context.Context, *RepoRefsParams) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListReleases(context.Context, *RepoRefsParams) (*ListReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListCommitsBetweenTags(context.Context, *CommitsBetweenTagsParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommitsBetweenTags not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) SearchCommits(context.Context, *SearchCommitsParams) (*SearchCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCommits not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListTopReviewers(context.Context, *CommitFilterParams) (*ListTopReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopReviewers not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) SetAuthorAliases(context.Context, *AuthorAliasesConfig) (*AuthorAliasesConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthorAliases not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetAuthorAliases(context.Context, *AuthorAliasesScope) (*AuthorAliasesConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorAliases not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetCommitActivity(context.Context, *CommitActivityParams) (*CommitActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitActivity not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) StartMonitoringOwnerRepositories(context.Context, *MonitorOwnerRepositoriesConfigParams) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMonitoringOwnerRepositories not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) StopMonitoringOwnerRepositories(context.Context, *StopMonitoringOwnerRepositoriesParams) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMonitoringOwnerRepositories not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ExportCommits(*CommitFilterParams, GitBeamCommitsService_ExportCommitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCommits not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListCommitsBetweenRefs(context.Context, *CommitRangeParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommitsBetweenRefs not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListAncestors(context.Context, *CommitGraphParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAncestors not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListDescendants(context.Context, *CommitGraphParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDescendants not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetMergeBase(context.Context, *CommitPairParams) (*Commit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMergeBase not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) IsAncestor(context.Context, *CommitPairParams) (*IsAncestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAncestor not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListCommitTypeBreakdown(context.Context, *CommitFilterParams) (*CommitTypeBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommitTypeBreakdown not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) SetIssuePatterns(context.Context, *IssuePatternsConfig) (*IssuePatternsConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIssuePatterns not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) GetIssuePatterns(context.Context, *RepoScope) (*IssuePatternsConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssuePatterns not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListIssueCommits(context.Context, *IssueCommitsParams) (*ListCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueCommits not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) RenameRepository(context.Context, *RenameRepositoryParams) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameRepository not implemented")
+}
+func (*UnimplementedGitBeamCommitsServiceServer) ListMonitoredRepositories(context.Context, *Void) (*ListMonitoredRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMonitoredRepositories not implemented")
+}
+
+func RegisterGitBeamCommitsServiceServer(s *grpc.Server, srv GitBeamCommitsServiceServer) {
+	s.RegisterService(&_GitBeamCommitsService_serviceDesc, srv)
+}
+
+func _GitBeamCommitsService_ListCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitFilterParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListCommits(ctx, req.(*CommitFilterParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_GetCommitByOwnerAndSHA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitByOwnerAndShaParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetCommitByOwnerAndSHA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetCommitByOwnerAndSHA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetCommitByOwnerAndSHA(ctx, req.(*CommitByOwnerAndShaParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListTopCommitAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitFilterParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListTopCommitAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListTopCommitAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListTopCommitAuthor(ctx, req.(*CommitFilterParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).HealthCheck(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_StartMonitoringRepositoryCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorRepositoryCommitsConfigParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).StartMonitoringRepositoryCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/StartMonitoringRepositoryCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).StartMonitoringRepositoryCommits(ctx, req.(*MonitorRepositoryCommitsConfigParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_StopMonitoringRepositoryCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopMonitoringRepositoryCommitParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).StopMonitoringRepositoryCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/StopMonitoringRepositoryCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).StopMonitoringRepositoryCommits(ctx, req.(*StopMonitoringRepositoryCommitParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoRefsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListTags(ctx, req.(*RepoRefsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoRefsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListReleases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListReleases(ctx, req.(*RepoRefsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_ListCommitsBetweenTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitsBetweenTagsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsBetweenTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListCommitsBetweenTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsBetweenTags(ctx, req.(*CommitsBetweenTagsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamCommitsService_SearchCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommitsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).SearchCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/SearchCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).SearchCommits(ctx, req.(*SearchCommitsParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gitBeamCommitsServiceClient) ListCommits(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListCommitResponse, error) {
-	out := new(ListCommitResponse)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListCommits", in, out, opts...)
-	if err != nil {
+func _GitBeamCommitsService_ListTopReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitFilterParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListTopReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListTopReviewers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListTopReviewers(ctx, req.(*CommitFilterParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gitBeamCommitsServiceClient) GetCommitByOwnerAndSHA(ctx context.Context, in *CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/GetCommitByOwnerAndSHA", in, out, opts...)
-	if err != nil {
+func _GitBeamCommitsService_SetAuthorAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorAliasesConfig)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).SetAuthorAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/SetAuthorAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).SetAuthorAliases(ctx, req.(*AuthorAliasesConfig))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gitBeamCommitsServiceClient) ListTopCommitAuthor(ctx context.Context, in *CommitFilterParams, opts ...grpc.CallOption) (*ListTopCommitAuthorResponse, error) {
-	out := new(ListTopCommitAuthorResponse)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/ListTopCommitAuthor", in, out, opts...)
-	if err != nil {
+func _GitBeamCommitsService_GetAuthorAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorAliasesScope)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetAuthorAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetAuthorAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetAuthorAliases(ctx, req.(*AuthorAliasesScope))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gitBeamCommitsServiceClient) HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/HealthCheck", in, out, opts...)
-	if err != nil {
+func _GitBeamCommitsService_GetCommitActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitActivityParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetCommitActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetCommitActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetCommitActivity(ctx, req.(*CommitActivityParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gitBeamCommitsServiceClient) StartMonitoringRepositoryCommits(ctx context.Context, in *MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StartMonitoringRepositoryCommits", in, out, opts...)
-	if err != nil {
+func _GitBeamCommitsService_StartMonitoringOwnerRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorOwnerRepositoriesConfigParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).StartMonitoringOwnerRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/StartMonitoringOwnerRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).StartMonitoringOwnerRepositories(ctx, req.(*MonitorOwnerRepositoriesConfigParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gitBeamCommitsServiceClient) StopMonitoringRepositoryCommits(ctx context.Context, in *StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/commits.GitBeamCommitsService/StopMonitoringRepositoryCommits", in, out, opts...)
-	if err != nil {
+func _GitBeamCommitsService_StopMonitoringOwnerRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopMonitoringOwnerRepositoriesParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).StopMonitoringOwnerRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/StopMonitoringOwnerRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).StopMonitoringOwnerRepositories(ctx, req.(*StopMonitoringOwnerRepositoriesParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GitBeamCommitsServiceServer is the server API for GitBeamCommitsService service.
-type GitBeamCommitsServiceServer interface {
-	ListCommits(context.Context, *CommitFilterParams) (*ListCommitResponse, error)
-	GetCommitByOwnerAndSHA(context.Context, *CommitByOwnerAndShaParams) (*Commit, error)
-	ListTopCommitAuthor(context.Context, *CommitFilterParams) (*ListTopCommitAuthorResponse, error)
-	HealthCheck(context.Context, *Void) (*HealthCheckResponse, error)
-	StartMonitoringRepositoryCommits(context.Context, *MonitorRepositoryCommitsConfigParams) (*Void, error)
-	StopMonitoringRepositoryCommits(context.Context, *StopMonitoringRepositoryCommitParams) (*Void, error)
+func _GitBeamCommitsService_ExportCommits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CommitFilterParams)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitBeamCommitsServiceServer).ExportCommits(m, &gitBeamCommitsServiceExportCommitsServer{stream})
 }
 
-// UnimplementedGitBeamCommitsServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedGitBeamCommitsServiceServer struct {
+type GitBeamCommitsService_ExportCommitsServer interface {
+	Send(*Commit) error
+	grpc.ServerStream
 }
 
-func (*UnimplementedGitBeamCommitsServiceServer) ListCommits(context.Context, *CommitFilterParams) (*ListCommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCommits not implemented")
+type gitBeamCommitsServiceExportCommitsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGitBeamCommitsServiceServer) GetCommitByOwnerAndSHA(context.Context, *CommitByOwnerAndShaParams) (*Commit, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommitByOwnerAndSHA not implemented")
+
+func (x *gitBeamCommitsServiceExportCommitsServer) Send(m *Commit) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGitBeamCommitsServiceServer) ListTopCommitAuthor(context.Context, *CommitFilterParams) (*ListTopCommitAuthorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTopCommitAuthor not implemented")
+
+func _GitBeamCommitsService_ListCommitsBetweenRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitRangeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsBetweenRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListCommitsBetweenRefs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListCommitsBetweenRefs(ctx, req.(*CommitRangeParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGitBeamCommitsServiceServer) HealthCheck(context.Context, *Void) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+
+func _GitBeamCommitsService_ListAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitGraphParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListAncestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListAncestors(ctx, req.(*CommitGraphParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGitBeamCommitsServiceServer) StartMonitoringRepositoryCommits(context.Context, *MonitorRepositoryCommitsConfigParams) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartMonitoringRepositoryCommits not implemented")
+
+func _GitBeamCommitsService_ListDescendants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitGraphParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).ListDescendants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/ListDescendants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).ListDescendants(ctx, req.(*CommitGraphParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGitBeamCommitsServiceServer) StopMonitoringRepositoryCommits(context.Context, *StopMonitoringRepositoryCommitParams) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopMonitoringRepositoryCommits not implemented")
+
+func _GitBeamCommitsService_GetMergeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitPairParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).GetMergeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/GetMergeBase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).GetMergeBase(ctx, req.(*CommitPairParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGitBeamCommitsServiceServer(s *grpc.Server, srv GitBeamCommitsServiceServer) {
-	s.RegisterService(&_GitBeamCommitsService_serviceDesc, srv)
+func _GitBeamCommitsService_IsAncestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitPairParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamCommitsServiceServer).IsAncestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commits.GitBeamCommitsService/IsAncestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamCommitsServiceServer).IsAncestor(ctx, req.(*CommitPairParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GitBeamCommitsService_ListCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _GitBeamCommitsService_ListCommitTypeBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommitFilterParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitBeamCommitsServiceServer).ListCommits(ctx, in)
+		return srv.(GitBeamCommitsServiceServer).ListCommitTypeBreakdown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/commits.GitBeamCommitsService/ListCommits",
+		FullMethod: "/commits.GitBeamCommitsService/ListCommitTypeBreakdown",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamCommitsServiceServer).ListCommits(ctx, req.(*CommitFilterParams))
+		return srv.(GitBeamCommitsServiceServer).ListCommitTypeBreakdown(ctx, req.(*CommitFilterParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitBeamCommitsService_GetCommitByOwnerAndSHA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitByOwnerAndShaParams)
+func _GitBeamCommitsService_SetIssuePatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuePatternsConfig)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitBeamCommitsServiceServer).GetCommitByOwnerAndSHA(ctx, in)
+		return srv.(GitBeamCommitsServiceServer).SetIssuePatterns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/commits.GitBeamCommitsService/GetCommitByOwnerAndSHA",
+		FullMethod: "/commits.GitBeamCommitsService/SetIssuePatterns",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamCommitsServiceServer).GetCommitByOwnerAndSHA(ctx, req.(*CommitByOwnerAndShaParams))
+		return srv.(GitBeamCommitsServiceServer).SetIssuePatterns(ctx, req.(*IssuePatternsConfig))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitBeamCommitsService_ListTopCommitAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitFilterParams)
+func _GitBeamCommitsService_GetIssuePatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoScope)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitBeamCommitsServiceServer).ListTopCommitAuthor(ctx, in)
+		return srv.(GitBeamCommitsServiceServer).GetIssuePatterns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/commits.GitBeamCommitsService/ListTopCommitAuthor",
+		FullMethod: "/commits.GitBeamCommitsService/GetIssuePatterns",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamCommitsServiceServer).ListTopCommitAuthor(ctx, req.(*CommitFilterParams))
+		return srv.(GitBeamCommitsServiceServer).GetIssuePatterns(ctx, req.(*RepoScope))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitBeamCommitsService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+func _GitBeamCommitsService_ListIssueCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCommitsParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitBeamCommitsServiceServer).HealthCheck(ctx, in)
+		return srv.(GitBeamCommitsServiceServer).ListIssueCommits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/commits.GitBeamCommitsService/HealthCheck",
+		FullMethod: "/commits.GitBeamCommitsService/ListIssueCommits",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamCommitsServiceServer).HealthCheck(ctx, req.(*Void))
+		return srv.(GitBeamCommitsServiceServer).ListIssueCommits(ctx, req.(*IssueCommitsParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitBeamCommitsService_StartMonitoringRepositoryCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MonitorRepositoryCommitsConfigParams)
+func _GitBeamCommitsService_RenameRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRepositoryParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitBeamCommitsServiceServer).StartMonitoringRepositoryCommits(ctx, in)
+		return srv.(GitBeamCommitsServiceServer).RenameRepository(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/commits.GitBeamCommitsService/StartMonitoringRepositoryCommits",
+		FullMethod: "/commits.GitBeamCommitsService/RenameRepository",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamCommitsServiceServer).StartMonitoringRepositoryCommits(ctx, req.(*MonitorRepositoryCommitsConfigParams))
+		return srv.(GitBeamCommitsServiceServer).RenameRepository(ctx, req.(*RenameRepositoryParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitBeamCommitsService_StopMonitoringRepositoryCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopMonitoringRepositoryCommitParams)
+func _GitBeamCommitsService_ListMonitoredRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitBeamCommitsServiceServer).StopMonitoringRepositoryCommits(ctx, in)
+		return srv.(GitBeamCommitsServiceServer).ListMonitoredRepositories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/commits.GitBeamCommitsService/StopMonitoringRepositoryCommits",
+		FullMethod: "/commits.GitBeamCommitsService/ListMonitoredRepositories",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamCommitsServiceServer).StopMonitoringRepositoryCommits(ctx, req.(*StopMonitoringRepositoryCommitParams))
+		return srv.(GitBeamCommitsServiceServer).ListMonitoredRepositories(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1212,7 +6260,97 @@ var _GitBeamCommitsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopMonitoringRepositoryCommits",
 			Handler:    _GitBeamCommitsService_StopMonitoringRepositoryCommits_Handler,
 		},
+		{
+			MethodName: "ListTags",
+			Handler:    _GitBeamCommitsService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListReleases",
+			Handler:    _GitBeamCommitsService_ListReleases_Handler,
+		},
+		{
+			MethodName: "ListCommitsBetweenTags",
+			Handler:    _GitBeamCommitsService_ListCommitsBetweenTags_Handler,
+		},
+		{
+			MethodName: "SearchCommits",
+			Handler:    _GitBeamCommitsService_SearchCommits_Handler,
+		},
+		{
+			MethodName: "ListTopReviewers",
+			Handler:    _GitBeamCommitsService_ListTopReviewers_Handler,
+		},
+		{
+			MethodName: "SetAuthorAliases",
+			Handler:    _GitBeamCommitsService_SetAuthorAliases_Handler,
+		},
+		{
+			MethodName: "GetAuthorAliases",
+			Handler:    _GitBeamCommitsService_GetAuthorAliases_Handler,
+		},
+		{
+			MethodName: "GetCommitActivity",
+			Handler:    _GitBeamCommitsService_GetCommitActivity_Handler,
+		},
+		{
+			MethodName: "StartMonitoringOwnerRepositories",
+			Handler:    _GitBeamCommitsService_StartMonitoringOwnerRepositories_Handler,
+		},
+		{
+			MethodName: "StopMonitoringOwnerRepositories",
+			Handler:    _GitBeamCommitsService_StopMonitoringOwnerRepositories_Handler,
+		},
+		{
+			MethodName: "ListCommitsBetweenRefs",
+			Handler:    _GitBeamCommitsService_ListCommitsBetweenRefs_Handler,
+		},
+		{
+			MethodName: "ListAncestors",
+			Handler:    _GitBeamCommitsService_ListAncestors_Handler,
+		},
+		{
+			MethodName: "ListDescendants",
+			Handler:    _GitBeamCommitsService_ListDescendants_Handler,
+		},
+		{
+			MethodName: "GetMergeBase",
+			Handler:    _GitBeamCommitsService_GetMergeBase_Handler,
+		},
+		{
+			MethodName: "IsAncestor",
+			Handler:    _GitBeamCommitsService_IsAncestor_Handler,
+		},
+		{
+			MethodName: "ListCommitTypeBreakdown",
+			Handler:    _GitBeamCommitsService_ListCommitTypeBreakdown_Handler,
+		},
+		{
+			MethodName: "SetIssuePatterns",
+			Handler:    _GitBeamCommitsService_SetIssuePatterns_Handler,
+		},
+		{
+			MethodName: "GetIssuePatterns",
+			Handler:    _GitBeamCommitsService_GetIssuePatterns_Handler,
+		},
+		{
+			MethodName: "ListIssueCommits",
+			Handler:    _GitBeamCommitsService_ListIssueCommits_Handler,
+		},
+		{
+			MethodName: "RenameRepository",
+			Handler:    _GitBeamCommitsService_RenameRepository_Handler,
+		},
+		{
+			MethodName: "ListMonitoredRepositories",
+			Handler:    _GitBeamCommitsService_ListMonitoredRepositories_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCommits",
+			Handler:       _GitBeamCommitsService_ExportCommits_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "commits/commits.proto",
 }
@@ -41,7 +41,17 @@ type Repo struct {
 	StarCounts    int64  `protobuf:"varint,9,opt,name=starCounts,proto3" json:"starCounts,omitempty"`
 	OpenIssues    int64  `protobuf:"varint,10,opt,name=openIssues,proto3" json:"openIssues,omitempty"`
 	WatchersCount int64  `protobuf:"varint,11,opt,name=watchersCount,proto3" json:"watchersCount,omitempty"`
-	Meta          string `protobuf:"bytes,12,opt,name=meta,proto3" json:"meta,omitempty"`
+	// When the counts above were last refreshed from GitHub.
+	LastRefreshedAt string `protobuf:"bytes,13,opt,name=lastRefreshedAt,proto3" json:"lastRefreshedAt,omitempty"`
+	// Whether the commit monitor is mirroring the commits of the repo.
+	Monitored bool `protobuf:"varint,14,opt,name=monitored,proto3" json:"monitored,omitempty"`
+	// The GitHub ID of the repo, which stays the same across renames and transfers.
+	ProviderId int64 `protobuf:"varint,15,opt,name=providerId,proto3" json:"providerId,omitempty"`
+	// One of active, archived or deleted.
+	Status string `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
+	// The owner and repo names the repo went by before it was renamed or transferred.
+	Aliases []*RepoAlias `protobuf:"bytes,17,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Meta    *RepoMeta    `protobuf:"bytes,18,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
 func (x *Repo) Reset() {
@@ -153,13 +163,632 @@ func (x *Repo) GetWatchersCount() int64 {
 	return 0
 }
 
-func (x *Repo) GetMeta() string {
+func (x *Repo) GetLastRefreshedAt() string {
+	if x != nil {
+		return x.LastRefreshedAt
+	}
+	return ""
+}
+
+func (x *Repo) GetMonitored() bool {
+	if x != nil {
+		return x.Monitored
+	}
+	return false
+}
+
+func (x *Repo) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *Repo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Repo) GetAliases() []*RepoAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Repo) GetMeta() *RepoMeta {
 	if x != nil {
 		return x.Meta
 	}
+	return nil
+}
+
+type RepoMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes of code per language, language above is the main one.
+	Languages map[string]int64 `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Topics    []string         `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// SPDX ID of the license, e.g. MIT.
+	License       string `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
+	DefaultBranch string `protobuf:"bytes,4,opt,name=defaultBranch,proto3" json:"defaultBranch,omitempty"`
+	Archived      bool   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	// One of public, private or internal.
+	Visibility string `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+}
+
+func (x *RepoMeta) Reset() {
+	*x = RepoMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoMeta) ProtoMessage() {}
+
+func (x *RepoMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoMeta.ProtoReflect.Descriptor instead.
+func (*RepoMeta) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepoMeta) GetLanguages() map[string]int64 {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *RepoMeta) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *RepoMeta) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *RepoMeta) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *RepoMeta) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *RepoMeta) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type RepoAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	// When the repo stopped going by this name.
+	Until string `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *RepoAlias) Reset() {
+	*x = RepoAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoAlias) ProtoMessage() {}
+
+func (x *RepoAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoAlias.ProtoReflect.Descriptor instead.
+func (*RepoAlias) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepoAlias) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RepoAlias) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RepoAlias) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+// Something that happened to a repo upstream, e.g. it was renamed, transferred, archived or deleted.
+type RepoEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of renamed, transferred, archived or deleted.
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ProviderId    int64  `protobuf:"varint,3,opt,name=providerId,proto3" json:"providerId,omitempty"`
+	FromOwnerName string `protobuf:"bytes,4,opt,name=fromOwnerName,proto3" json:"fromOwnerName,omitempty"`
+	FromRepoName  string `protobuf:"bytes,5,opt,name=fromRepoName,proto3" json:"fromRepoName,omitempty"`
+	ToOwnerName   string `protobuf:"bytes,6,opt,name=toOwnerName,proto3" json:"toOwnerName,omitempty"`
+	ToRepoName    string `protobuf:"bytes,7,opt,name=toRepoName,proto3" json:"toRepoName,omitempty"`
+	OccurredAt    string `protobuf:"bytes,8,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+}
+
+func (x *RepoEvent) Reset() {
+	*x = RepoEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoEvent) ProtoMessage() {}
+
+func (x *RepoEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoEvent.ProtoReflect.Descriptor instead.
+func (*RepoEvent) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepoEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *RepoEvent) GetFromOwnerName() string {
+	if x != nil {
+		return x.FromOwnerName
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetFromRepoName() string {
+	if x != nil {
+		return x.FromRepoName
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetToOwnerName() string {
+	if x != nil {
+		return x.ToOwnerName
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetToRepoName() string {
+	if x != nil {
+		return x.ToRepoName
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ListRepoEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	Page      int64  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListRepoEventsRequest) Reset() {
+	*x = ListRepoEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepoEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepoEventsRequest) ProtoMessage() {}
+
+func (x *ListRepoEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepoEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListRepoEventsRequest) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRepoEventsRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *ListRepoEventsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ListRepoEventsRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRepoEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRepoEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*RepoEvent `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListRepoEventsResponse) Reset() {
+	*x = ListRepoEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepoEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepoEventsResponse) ProtoMessage() {}
+
+func (x *ListRepoEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepoEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListRepoEventsResponse) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRepoEventsResponse) GetData() []*RepoEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Star, fork, issue and watcher counts of a repo at a point in time.
+type RepoStatsSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordedAt    string `protobuf:"bytes,1,opt,name=recordedAt,proto3" json:"recordedAt,omitempty"`
+	StarCounts    int64  `protobuf:"varint,2,opt,name=starCounts,proto3" json:"starCounts,omitempty"`
+	ForkCounts    int64  `protobuf:"varint,3,opt,name=forkCounts,proto3" json:"forkCounts,omitempty"`
+	OpenIssues    int64  `protobuf:"varint,4,opt,name=openIssues,proto3" json:"openIssues,omitempty"`
+	WatchersCount int64  `protobuf:"varint,5,opt,name=watchersCount,proto3" json:"watchersCount,omitempty"`
+}
+
+func (x *RepoStatsSnapshot) Reset() {
+	*x = RepoStatsSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoStatsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoStatsSnapshot) ProtoMessage() {}
+
+func (x *RepoStatsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoStatsSnapshot.ProtoReflect.Descriptor instead.
+func (*RepoStatsSnapshot) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RepoStatsSnapshot) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+func (x *RepoStatsSnapshot) GetStarCounts() int64 {
+	if x != nil {
+		return x.StarCounts
+	}
+	return 0
+}
+
+func (x *RepoStatsSnapshot) GetForkCounts() int64 {
+	if x != nil {
+		return x.ForkCounts
+	}
+	return 0
+}
+
+func (x *RepoStatsSnapshot) GetOpenIssues() int64 {
+	if x != nil {
+		return x.OpenIssues
+	}
+	return 0
+}
+
+func (x *RepoStatsSnapshot) GetWatchersCount() int64 {
+	if x != nil {
+		return x.WatchersCount
+	}
+	return 0
+}
+
+type RepoStatsHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+	FromDate  string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate    string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	// Keeps the last snapshot of every day, week or month.
+	Interval string `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *RepoStatsHistoryRequest) Reset() {
+	*x = RepoStatsHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoStatsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoStatsHistoryRequest) ProtoMessage() {}
+
+func (x *RepoStatsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoStatsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RepoStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RepoStatsHistoryRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *RepoStatsHistoryRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RepoStatsHistoryRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *RepoStatsHistoryRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *RepoStatsHistoryRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
 	return ""
 }
 
+type RepoStatsHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*RepoStatsSnapshot `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RepoStatsHistoryResponse) Reset() {
+	*x = RepoStatsHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoStatsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoStatsHistoryResponse) ProtoMessage() {}
+
+func (x *RepoStatsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoStatsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RepoStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RepoStatsHistoryResponse) GetData() []*RepoStatsSnapshot {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type Void struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -169,7 +798,7 @@ type Void struct {
 func (x *Void) Reset() {
 	*x = Void{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repos_repos_proto_msgTypes[1]
+		mi := &file_repos_repos_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -182,7 +811,7 @@ func (x *Void) String() string {
 func (*Void) ProtoMessage() {}
 
 func (x *Void) ProtoReflect() protoreflect.Message {
-	mi := &file_repos_repos_proto_msgTypes[1]
+	mi := &file_repos_repos_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +824,122 @@ func (x *Void) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Void.ProtoReflect.Descriptor instead.
 func (*Void) Descriptor() ([]byte, []int) {
-	return file_repos_repos_proto_rawDescGZIP(), []int{1}
+	return file_repos_repos_proto_rawDescGZIP(), []int{9}
+}
+
+// Request message for listGitRepositories, every filter is optional.
+type ListGitRepositoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	Language  string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// Matches repos whose name contains it, case insensitive.
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MinStars  int64  `protobuf:"varint,4,opt,name=minStars,proto3" json:"minStars,omitempty"`
+	Monitored *bool  `protobuf:"varint,5,opt,name=monitored,proto3,oneof" json:"monitored,omitempty"`
+	// One of stars, updated or name.
+	SortBy string `protobuf:"bytes,6,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	// One of asc or desc.
+	Order string `protobuf:"bytes,7,opt,name=order,proto3" json:"order,omitempty"`
+	Page  int64  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int64  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListGitRepositoriesRequest) Reset() {
+	*x = ListGitRepositoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGitRepositoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGitRepositoriesRequest) ProtoMessage() {}
+
+func (x *ListGitRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGitRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListGitRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListGitRepositoriesRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *ListGitRepositoriesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListGitRepositoriesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListGitRepositoriesRequest) GetMinStars() int64 {
+	if x != nil {
+		return x.MinStars
+	}
+	return 0
+}
+
+func (x *ListGitRepositoriesRequest) GetMonitored() bool {
+	if x != nil && x.Monitored != nil {
+		return *x.Monitored
+	}
+	return false
+}
+
+func (x *ListGitRepositoriesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListGitRepositoriesRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListGitRepositoriesRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGitRepositoriesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
 // Response message for listGitRepositories
@@ -210,7 +954,7 @@ type ListGitRepositoriesResponse struct {
 func (x *ListGitRepositoriesResponse) Reset() {
 	*x = ListGitRepositoriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repos_repos_proto_msgTypes[2]
+		mi := &file_repos_repos_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -223,7 +967,56 @@ func (x *ListGitRepositoriesResponse) String() string {
 func (*ListGitRepositoriesResponse) ProtoMessage() {}
 
 func (x *ListGitRepositoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repos_repos_proto_msgTypes[2]
+	mi := &file_repos_repos_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGitRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListGitRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListGitRepositoriesResponse) GetRepos() []*Repo {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
+
+// Request message for getGitRepo, the names may be the ones the repo went by before a rename.
+type GetGitRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerName string `protobuf:"bytes,1,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
+}
+
+func (x *GetGitRepoRequest) Reset() {
+	*x = GetGitRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repos_repos_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGitRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGitRepoRequest) ProtoMessage() {}
+
+func (x *GetGitRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,20 +1027,27 @@ func (x *ListGitRepositoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGitRepositoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListGitRepositoriesResponse) Descriptor() ([]byte, []int) {
-	return file_repos_repos_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetGitRepoRequest.ProtoReflect.Descriptor instead.
+func (*GetGitRepoRequest) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListGitRepositoriesResponse) GetRepos() []*Repo {
+func (x *GetGitRepoRequest) GetOwnerName() string {
 	if x != nil {
-		return x.Repos
+		return x.OwnerName
 	}
-	return nil
+	return ""
 }
 
-// Request message for getGitRepo
-type GetGitRepoRequest struct {
+func (x *GetGitRepoRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+// Request message for registerGitRepo
+type RegisterGitRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -256,23 +1056,23 @@ type GetGitRepoRequest struct {
 	RepoName  string `protobuf:"bytes,2,opt,name=repoName,proto3" json:"repoName,omitempty"`
 }
 
-func (x *GetGitRepoRequest) Reset() {
-	*x = GetGitRepoRequest{}
+func (x *RegisterGitRepoRequest) Reset() {
+	*x = RegisterGitRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repos_repos_proto_msgTypes[3]
+		mi := &file_repos_repos_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGitRepoRequest) String() string {
+func (x *RegisterGitRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitRepoRequest) ProtoMessage() {}
+func (*RegisterGitRepoRequest) ProtoMessage() {}
 
-func (x *GetGitRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_repos_repos_proto_msgTypes[3]
+func (x *RegisterGitRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repos_repos_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,19 +1083,19 @@ func (x *GetGitRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitRepoRequest.ProtoReflect.Descriptor instead.
-func (*GetGitRepoRequest) Descriptor() ([]byte, []int) {
-	return file_repos_repos_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RegisterGitRepoRequest.ProtoReflect.Descriptor instead.
+func (*RegisterGitRepoRequest) Descriptor() ([]byte, []int) {
+	return file_repos_repos_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetGitRepoRequest) GetOwnerName() string {
+func (x *RegisterGitRepoRequest) GetOwnerName() string {
 	if x != nil {
 		return x.OwnerName
 	}
 	return ""
 }
 
-func (x *GetGitRepoRequest) GetRepoName() string {
+func (x *RegisterGitRepoRequest) GetRepoName() string {
 	if x != nil {
 		return x.RepoName
 	}
@@ -313,7 +1113,7 @@ type HealthCheckResponse struct {
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_repos_repos_proto_msgTypes[4]
+		mi := &file_repos_repos_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -326,7 +1126,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_repos_repos_proto_msgTypes[4]
+	mi := &file_repos_repos_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +1139,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_repos_repos_proto_rawDescGZIP(), []int{4}
+	return file_repos_repos_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *HealthCheckResponse) GetCode() int64 {
@@ -353,7 +1153,7 @@ var File_repos_repos_proto protoreflect.FileDescriptor
 
 var file_repos_repos_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x08, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xde, 0x02,
+	0x6f, 0x74, 0x6f, 0x12, 0x08, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xa7, 0x04,
 	0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x69, 0x6d,
 	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65,
@@ -374,37 +1174,176 @@ var file_repos_repos_proto_rawDesc = []byte{
 	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x73,
 	0x73, 0x75, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73,
 	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x06,
-	0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0xe7, 0x01, 0x0a, 0x18, 0x47, 0x69, 0x74, 0x42, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x67, 0x69, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47,
-	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e,
-	0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1d,
-	0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x68, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x22, 0x9d, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0x3c, 0x0a, 0x0e, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x22, 0xfb, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x72, 0x6f, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x7b, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x41, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xb9, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3,
+	0x01, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x22, 0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x52, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x47, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0xfc,
+	0x04, 0x0a, 0x18, 0x47, 0x69, 0x74, 0x42, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12,
+	0x1b, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x2e,
+	0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1d, 0x2e,
+	0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a,
+	0x0a, 0x2e, 0x3b, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -419,27 +1358,53 @@ func file_repos_repos_proto_rawDescGZIP() []byte {
 	return file_repos_repos_proto_rawDescData
 }
 
-var file_repos_repos_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_repos_repos_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_repos_repos_proto_goTypes = []interface{}{
 	(*Repo)(nil),                        // 0: gitRepos.Repo
-	(*Void)(nil),                        // 1: gitRepos.Void
-	(*ListGitRepositoriesResponse)(nil), // 2: gitRepos.ListGitRepositoriesResponse
-	(*GetGitRepoRequest)(nil),           // 3: gitRepos.GetGitRepoRequest
-	(*HealthCheckResponse)(nil),         // 4: gitRepos.HealthCheckResponse
+	(*RepoMeta)(nil),                    // 1: gitRepos.RepoMeta
+	(*RepoAlias)(nil),                   // 2: gitRepos.RepoAlias
+	(*RepoEvent)(nil),                   // 3: gitRepos.RepoEvent
+	(*ListRepoEventsRequest)(nil),       // 4: gitRepos.ListRepoEventsRequest
+	(*ListRepoEventsResponse)(nil),      // 5: gitRepos.ListRepoEventsResponse
+	(*RepoStatsSnapshot)(nil),           // 6: gitRepos.RepoStatsSnapshot
+	(*RepoStatsHistoryRequest)(nil),     // 7: gitRepos.RepoStatsHistoryRequest
+	(*RepoStatsHistoryResponse)(nil),    // 8: gitRepos.RepoStatsHistoryResponse
+	(*Void)(nil),                        // 9: gitRepos.Void
+	(*ListGitRepositoriesRequest)(nil),  // 10: gitRepos.ListGitRepositoriesRequest
+	(*ListGitRepositoriesResponse)(nil), // 11: gitRepos.ListGitRepositoriesResponse
+	(*GetGitRepoRequest)(nil),           // 12: gitRepos.GetGitRepoRequest
+	(*RegisterGitRepoRequest)(nil),      // 13: gitRepos.RegisterGitRepoRequest
+	(*HealthCheckResponse)(nil),         // 14: gitRepos.HealthCheckResponse
+	nil,                                 // 15: gitRepos.RepoMeta.LanguagesEntry
 }
 var file_repos_repos_proto_depIdxs = []int32{
-	0, // 0: gitRepos.ListGitRepositoriesResponse.repos:type_name -> gitRepos.Repo
-	1, // 1: gitRepos.GitBeamRepositoryService.ListGitRepositories:input_type -> gitRepos.Void
-	3, // 2: gitRepos.GitBeamRepositoryService.GetGitRepo:input_type -> gitRepos.GetGitRepoRequest
-	1, // 3: gitRepos.GitBeamRepositoryService.HealthCheck:input_type -> gitRepos.Void
-	2, // 4: gitRepos.GitBeamRepositoryService.ListGitRepositories:output_type -> gitRepos.ListGitRepositoriesResponse
-	0, // 5: gitRepos.GitBeamRepositoryService.GetGitRepo:output_type -> gitRepos.Repo
-	4, // 6: gitRepos.GitBeamRepositoryService.HealthCheck:output_type -> gitRepos.HealthCheckResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: gitRepos.Repo.aliases:type_name -> gitRepos.RepoAlias
+	1,  // 1: gitRepos.Repo.meta:type_name -> gitRepos.RepoMeta
+	15, // 2: gitRepos.RepoMeta.languages:type_name -> gitRepos.RepoMeta.LanguagesEntry
+	3,  // 3: gitRepos.ListRepoEventsResponse.data:type_name -> gitRepos.RepoEvent
+	6,  // 4: gitRepos.RepoStatsHistoryResponse.data:type_name -> gitRepos.RepoStatsSnapshot
+	0,  // 5: gitRepos.ListGitRepositoriesResponse.repos:type_name -> gitRepos.Repo
+	10, // 6: gitRepos.GitBeamRepositoryService.ListGitRepositories:input_type -> gitRepos.ListGitRepositoriesRequest
+	12, // 7: gitRepos.GitBeamRepositoryService.GetGitRepo:input_type -> gitRepos.GetGitRepoRequest
+	9,  // 8: gitRepos.GitBeamRepositoryService.HealthCheck:input_type -> gitRepos.Void
+	7,  // 9: gitRepos.GitBeamRepositoryService.GetRepoStatsHistory:input_type -> gitRepos.RepoStatsHistoryRequest
+	13, // 10: gitRepos.GitBeamRepositoryService.RegisterGitRepo:input_type -> gitRepos.RegisterGitRepoRequest
+	12, // 11: gitRepos.GitBeamRepositoryService.RefreshGitRepo:input_type -> gitRepos.GetGitRepoRequest
+	12, // 12: gitRepos.GitBeamRepositoryService.DeleteGitRepo:input_type -> gitRepos.GetGitRepoRequest
+	4,  // 13: gitRepos.GitBeamRepositoryService.ListRepoEvents:input_type -> gitRepos.ListRepoEventsRequest
+	11, // 14: gitRepos.GitBeamRepositoryService.ListGitRepositories:output_type -> gitRepos.ListGitRepositoriesResponse
+	0,  // 15: gitRepos.GitBeamRepositoryService.GetGitRepo:output_type -> gitRepos.Repo
+	14, // 16: gitRepos.GitBeamRepositoryService.HealthCheck:output_type -> gitRepos.HealthCheckResponse
+	8,  // 17: gitRepos.GitBeamRepositoryService.GetRepoStatsHistory:output_type -> gitRepos.RepoStatsHistoryResponse
+	0,  // 18: gitRepos.GitBeamRepositoryService.RegisterGitRepo:output_type -> gitRepos.Repo
+	0,  // 19: gitRepos.GitBeamRepositoryService.RefreshGitRepo:output_type -> gitRepos.Repo
+	9,  // 20: gitRepos.GitBeamRepositoryService.DeleteGitRepo:output_type -> gitRepos.Void
+	5,  // 21: gitRepos.GitBeamRepositoryService.ListRepoEvents:output_type -> gitRepos.ListRepoEventsResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_repos_repos_proto_init() }
@@ -461,7 +1426,7 @@ func file_repos_repos_proto_init() {
 			}
 		}
 		file_repos_repos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Void); i {
+			switch v := v.(*RepoMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -473,7 +1438,7 @@ func file_repos_repos_proto_init() {
 			}
 		}
 		file_repos_repos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGitRepositoriesResponse); i {
+			switch v := v.(*RepoAlias); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -485,7 +1450,7 @@ func file_repos_repos_proto_init() {
 			}
 		}
 		file_repos_repos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGitRepoRequest); i {
+			switch v := v.(*RepoEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -497,6 +1462,126 @@ func file_repos_repos_proto_init() {
 			}
 		}
 		file_repos_repos_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoStatsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoStatsHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoStatsHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Void); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitRepositoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitRepositoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGitRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterGitRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repos_repos_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthCheckResponse); i {
 			case 0:
 				return &v.state
@@ -509,13 +1594,14 @@ func file_repos_repos_proto_init() {
 			}
 		}
 	}
+	file_repos_repos_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_repos_repos_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -541,9 +1627,16 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GitBeamRepositoryServiceClient interface {
-	ListGitRepositories(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListGitRepositoriesResponse, error)
+	ListGitRepositories(ctx context.Context, in *ListGitRepositoriesRequest, opts ...grpc.CallOption) (*ListGitRepositoriesResponse, error)
 	GetGitRepo(ctx context.Context, in *GetGitRepoRequest, opts ...grpc.CallOption) (*Repo, error)
 	HealthCheck(ctx context.Context, in *Void, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	GetRepoStatsHistory(ctx context.Context, in *RepoStatsHistoryRequest, opts ...grpc.CallOption) (*RepoStatsHistoryResponse, error)
+	// Pulls the repo from GitHub and stores it, registering an already known repo refreshes it.
+	RegisterGitRepo(ctx context.Context, in *RegisterGitRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	// Re-pulls the metadata of a known repo from GitHub.
+	RefreshGitRepo(ctx context.Context, in *GetGitRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	DeleteGitRepo(ctx context.Context, in *GetGitRepoRequest, opts ...grpc.CallOption) (*Void, error)
+	ListRepoEvents(ctx context.Context, in *ListRepoEventsRequest, opts ...grpc.CallOption) (*ListRepoEventsResponse, error)
 }
 
 type gitBeamRepositoryServiceClient struct {
@@ -554,7 +1647,7 @@ func NewGitBeamRepositoryServiceClient(cc grpc.ClientConnInterface) GitBeamRepos
 	return &gitBeamRepositoryServiceClient{cc}
 }
 
-func (c *gitBeamRepositoryServiceClient) ListGitRepositories(ctx context.Context, in *Void, opts ...grpc.CallOption) (*ListGitRepositoriesResponse, error) {
+func (c *gitBeamRepositoryServiceClient) ListGitRepositories(ctx context.Context, in *ListGitRepositoriesRequest, opts ...grpc.CallOption) (*ListGitRepositoriesResponse, error) {
 	out := new(ListGitRepositoriesResponse)
 	err := c.cc.Invoke(ctx, "/gitRepos.GitBeamRepositoryService/ListGitRepositories", in, out, opts...)
 	if err != nil {
@@ -581,18 +1674,70 @@ func (c *gitBeamRepositoryServiceClient) HealthCheck(ctx context.Context, in *Vo
 	return out, nil
 }
 
+func (c *gitBeamRepositoryServiceClient) GetRepoStatsHistory(ctx context.Context, in *RepoStatsHistoryRequest, opts ...grpc.CallOption) (*RepoStatsHistoryResponse, error) {
+	out := new(RepoStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gitRepos.GitBeamRepositoryService/GetRepoStatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamRepositoryServiceClient) RegisterGitRepo(ctx context.Context, in *RegisterGitRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, "/gitRepos.GitBeamRepositoryService/RegisterGitRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamRepositoryServiceClient) RefreshGitRepo(ctx context.Context, in *GetGitRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, "/gitRepos.GitBeamRepositoryService/RefreshGitRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamRepositoryServiceClient) DeleteGitRepo(ctx context.Context, in *GetGitRepoRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/gitRepos.GitBeamRepositoryService/DeleteGitRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitBeamRepositoryServiceClient) ListRepoEvents(ctx context.Context, in *ListRepoEventsRequest, opts ...grpc.CallOption) (*ListRepoEventsResponse, error) {
+	out := new(ListRepoEventsResponse)
+	err := c.cc.Invoke(ctx, "/gitRepos.GitBeamRepositoryService/ListRepoEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GitBeamRepositoryServiceServer is the server API for GitBeamRepositoryService service.
 type GitBeamRepositoryServiceServer interface {
-	ListGitRepositories(context.Context, *Void) (*ListGitRepositoriesResponse, error)
+	ListGitRepositories(context.Context, *ListGitRepositoriesRequest) (*ListGitRepositoriesResponse, error)
 	GetGitRepo(context.Context, *GetGitRepoRequest) (*Repo, error)
 	HealthCheck(context.Context, *Void) (*HealthCheckResponse, error)
+	GetRepoStatsHistory(context.Context, *RepoStatsHistoryRequest) (*RepoStatsHistoryResponse, error)
+	// Pulls the repo from GitHub and stores it, registering an already known repo refreshes it.
+	RegisterGitRepo(context.Context, *RegisterGitRepoRequest) (*Repo, error)
+	// Re-pulls the metadata of a known repo from GitHub.
+	RefreshGitRepo(context.Context, *GetGitRepoRequest) (*Repo, error)
+	DeleteGitRepo(context.Context, *GetGitRepoRequest) (*Void, error)
+	ListRepoEvents(context.Context, *ListRepoEventsRequest) (*ListRepoEventsResponse, error)
 }
 
 // UnimplementedGitBeamRepositoryServiceServer can be embedded to have forward compatible implementations.
 type UnimplementedGitBeamRepositoryServiceServer struct {
 }
 
-func (*UnimplementedGitBeamRepositoryServiceServer) ListGitRepositories(context.Context, *Void) (*ListGitRepositoriesResponse, error) {
+func (*UnimplementedGitBeamRepositoryServiceServer) ListGitRepositories(context.Context, *ListGitRepositoriesRequest) (*ListGitRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGitRepositories not implemented")
 }
 func (*UnimplementedGitBeamRepositoryServiceServer) GetGitRepo(context.Context, *GetGitRepoRequest) (*Repo, error) {
@@ -601,13 +1746,28 @@ func (*UnimplementedGitBeamRepositoryServiceServer) GetGitRepo(context.Context,
 func (*UnimplementedGitBeamRepositoryServiceServer) HealthCheck(context.Context, *Void) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (*UnimplementedGitBeamRepositoryServiceServer) GetRepoStatsHistory(context.Context, *RepoStatsHistoryRequest) (*RepoStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepoStatsHistory not implemented")
+}
+func (*UnimplementedGitBeamRepositoryServiceServer) RegisterGitRepo(context.Context, *RegisterGitRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGitRepo not implemented")
+}
+func (*UnimplementedGitBeamRepositoryServiceServer) RefreshGitRepo(context.Context, *GetGitRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshGitRepo not implemented")
+}
+func (*UnimplementedGitBeamRepositoryServiceServer) DeleteGitRepo(context.Context, *GetGitRepoRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGitRepo not implemented")
+}
+func (*UnimplementedGitBeamRepositoryServiceServer) ListRepoEvents(context.Context, *ListRepoEventsRequest) (*ListRepoEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepoEvents not implemented")
+}
 
 func RegisterGitBeamRepositoryServiceServer(s *grpc.Server, srv GitBeamRepositoryServiceServer) {
 	s.RegisterService(&_GitBeamRepositoryService_serviceDesc, srv)
 }
 
 func _GitBeamRepositoryService_ListGitRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+	in := new(ListGitRepositoriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -619,7 +1779,7 @@ func _GitBeamRepositoryService_ListGitRepositories_Handler(srv interface{}, ctx
 		FullMethod: "/gitRepos.GitBeamRepositoryService/ListGitRepositories",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitBeamRepositoryServiceServer).ListGitRepositories(ctx, req.(*Void))
+		return srv.(GitBeamRepositoryServiceServer).ListGitRepositories(ctx, req.(*ListGitRepositoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -660,6 +1820,96 @@ func _GitBeamRepositoryService_HealthCheck_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitBeamRepositoryService_GetRepoStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamRepositoryServiceServer).GetRepoStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitRepos.GitBeamRepositoryService/GetRepoStatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamRepositoryServiceServer).GetRepoStatsHistory(ctx, req.(*RepoStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamRepositoryService_RegisterGitRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterGitRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamRepositoryServiceServer).RegisterGitRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitRepos.GitBeamRepositoryService/RegisterGitRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamRepositoryServiceServer).RegisterGitRepo(ctx, req.(*RegisterGitRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamRepositoryService_RefreshGitRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamRepositoryServiceServer).RefreshGitRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitRepos.GitBeamRepositoryService/RefreshGitRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamRepositoryServiceServer).RefreshGitRepo(ctx, req.(*GetGitRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamRepositoryService_DeleteGitRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamRepositoryServiceServer).DeleteGitRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitRepos.GitBeamRepositoryService/DeleteGitRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamRepositoryServiceServer).DeleteGitRepo(ctx, req.(*GetGitRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitBeamRepositoryService_ListRepoEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepoEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitBeamRepositoryServiceServer).ListRepoEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitRepos.GitBeamRepositoryService/ListRepoEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitBeamRepositoryServiceServer).ListRepoEvents(ctx, req.(*ListRepoEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GitBeamRepositoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gitRepos.GitBeamRepositoryService",
 	HandlerType: (*GitBeamRepositoryServiceServer)(nil),
@@ -676,6 +1926,26 @@ var _GitBeamRepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HealthCheck",
 			Handler:    _GitBeamRepositoryService_HealthCheck_Handler,
 		},
+		{
+			MethodName: "GetRepoStatsHistory",
+			Handler:    _GitBeamRepositoryService_GetRepoStatsHistory_Handler,
+		},
+		{
+			MethodName: "RegisterGitRepo",
+			Handler:    _GitBeamRepositoryService_RegisterGitRepo_Handler,
+		},
+		{
+			MethodName: "RefreshGitRepo",
+			Handler:    _GitBeamRepositoryService_RefreshGitRepo_Handler,
+		},
+		{
+			MethodName: "DeleteGitRepo",
+			Handler:    _GitBeamRepositoryService_DeleteGitRepo_Handler,
+		},
+		{
+			MethodName: "ListRepoEvents",
+			Handler:    _GitBeamRepositoryService_ListRepoEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "repos/repos.proto",
@@ -1,9 +1,14 @@
 package api
 
 import (
+	"gitbeam/api/pb/commits"
 	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/changelog"
+	"gitbeam/models"
 	"gitbeam/utils"
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 )
 
@@ -11,15 +16,47 @@ func (a API) newReposRoute() chi.Router {
 	router := chi.NewRouter()
 
 	router.Get("/{ownerName}/{repoName}", a.getRepoByOwnerAndRepoName)
+	router.Get("/{ownerName}/{repoName}/tags", a.listRepoTags)
+	router.Get("/{ownerName}/{repoName}/tags/compare", a.listCommitsBetweenTags)
+	router.Get("/{ownerName}/{repoName}/releases", a.listRepoReleases)
+	router.Get("/{ownerName}/{repoName}/changelog", a.getRepoChangelog)
+	router.Get("/{ownerName}/{repoName}/stats/history", a.getRepoStatsHistory)
+	router.Get("/{ownerName}/{repoName}/events", a.listRepoEvents)
 	router.Get("/", a.listRepositories)
+	router.Post("/", a.registerRepository)
+	router.Post("/{ownerName}/{repoName}/refresh", a.refreshRepository)
+	router.Delete("/{ownerName}/{repoName}", a.deleteRepository)
 
 	return router
 }
 
 func (a API) listRepositories(w http.ResponseWriter, r *http.Request) {
-	repo, err := a.reposRPC.ListGitRepositories(r.Context(), &gitRepos.Void{})
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listRepositories").Logger
+	var filter models.RepoFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	repo, err := a.reposRPC.ListGitRepositories(r.Context(), &gitRepos.ListGitRepositoriesRequest{
+		OwnerName: filter.OwnerName,
+		Language:  filter.Language,
+		Name:      filter.Name,
+		MinStars:  filter.MinStars,
+		Monitored: filter.Monitored,
+		SortBy:    filter.SortBy,
+		Order:     filter.Order,
+		Page:      filter.Page,
+		Limit:     filter.Limit,
+	})
 	if err != nil {
-		a.logger.WithError(err).Error("failed to fetch list of repositories")
+		useLogger.WithError(err).Error("failed to fetch list of repositories")
 		statusCode := http.StatusBadRequest
 		//if errors.Is(err, core.ErrGithubRepoNotFound) {
 		//	statusCode = http.StatusNotFound
@@ -32,6 +69,105 @@ func (a API) listRepositories(w http.ResponseWriter, r *http.Request) {
 	utils.WriteHTTPSuccess(w, "Successfully retrieved list of repositories", repo.Repos)
 }
 
+// registerRepository pulls a repo from GitHub into the repo manager, by owner and repo name or by URL.
+func (a API) registerRepository(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "registerRepository").Logger
+	var payload models.RegisterRepoRequest
+	err := decodeJSON(r.Body, &payload)
+	if err == nil {
+		err = payload.Resolve()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid payload.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	repo, err := a.reposRPC.RegisterGitRepo(r.Context(), &gitRepos.RegisterGitRepoRequest{
+		OwnerName: payload.OwnerName,
+		RepoName:  payload.RepoName,
+	})
+	if err != nil {
+		useLogger.WithError(err).WithField("payload", payload).Error("failed to register repo")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully registered repo", repo)
+}
+
+func (a API) refreshRepository(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "refreshRepository").Logger
+	repo, err := a.reposRPC.RefreshGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to refresh repo")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully refreshed repo", repo)
+}
+
+// deleteRepository stops monitoring the commits of a repo before removing it from the repo manager,
+// purgeCommits=true also deletes the commits, tags and releases mirrored so far.
+func (a API) deleteRepository(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "deleteRepository").Logger
+	var filter models.DeleteRepoFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	// Act on the current names of the repo, the ones in the URL may be the ones it went by before a
+	// rename or transfer.
+	repo, err := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to get repo from repo rpc service.")
+		utils.WriteHTTPError(w, http.StatusNotFound, err)
+		return
+	}
+
+	// Repos registered but never monitored have nothing to stop, unless their leftover commits are purged.
+	if repo.GetMonitored() || filter.PurgeCommits {
+		_, err = a.commitsRPC.StopMonitoringRepositoryCommits(r.Context(), &commits.StopMonitoringRepositoryCommitParams{
+			OwnerName:    repo.Owner,
+			RepoName:     repo.Name,
+			PurgeCommits: filter.PurgeCommits,
+		})
+		if status.Code(err) == codes.NotFound {
+			// The commit monitor doesn't know the repo, which is as stopped as it gets.
+			err = nil
+		}
+
+		if err != nil {
+			useLogger.WithError(err).Error("failed to stop monitoring repository commits")
+			utils.WriteHTTPError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	_, err = a.reposRPC.DeleteGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
+		OwnerName: repo.Owner,
+		RepoName:  repo.Name,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to delete repo")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully deleted repo", nil)
+}
+
 func (a API) getRepoByOwnerAndRepoName(w http.ResponseWriter, r *http.Request) {
 	repo, err := a.reposRPC.GetGitRepo(r.Context(), &gitRepos.GetGitRepoRequest{
 		OwnerName: chi.URLParam(r, "ownerName"),
@@ -48,3 +184,218 @@ func (a API) getRepoByOwnerAndRepoName(w http.ResponseWriter, r *http.Request) {
 	utils.WriteHTTPSuccess(w, "Successfully retrieved repo", repo)
 
 }
+
+func (a API) listRepoTags(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listRepoTags").Logger
+	var page models.Pagination
+	err := decodeQuery(r.URL.Query(), &page)
+	if err == nil {
+		err = page.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	list, err := a.commitsRPC.ListTags(r.Context(), &commits.RepoRefsParams{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		Page:      page.Page,
+		Limit:     page.Limit,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch list of tags")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved list of tags", list.Data)
+}
+
+func (a API) listRepoReleases(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listRepoReleases").Logger
+	var page models.Pagination
+	err := decodeQuery(r.URL.Query(), &page)
+	if err == nil {
+		err = page.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	list, err := a.commitsRPC.ListReleases(r.Context(), &commits.RepoRefsParams{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		Page:      page.Page,
+		Limit:     page.Limit,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch list of releases")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved list of releases", list.Data)
+}
+
+// listCommitsBetweenTags returns the commits reachable from toTag but not from fromTag, which is
+// what a changelog between two releases is made of.
+func (a API) listCommitsBetweenTags(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listCommitsBetweenTags").Logger
+	var tagRange models.TagRange
+	err := decodeQuery(r.URL.Query(), &tagRange)
+	if err == nil {
+		err = tagRange.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	list, err := a.commitsRPC.ListCommitsBetweenTags(r.Context(), &commits.CommitsBetweenTagsParams{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		FromTag:   tagRange.FromTag,
+		ToTag:     tagRange.ToTag,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch commits between tags")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved commits between tags", list.Data)
+}
+
+// getRepoChangelog builds the changelog of the commits between two refs ( sha, tag or date ), grouped
+// by conventional commit type or by trailer, as markdown or json.
+func (a API) getRepoChangelog(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getRepoChangelog").Logger
+	var filter models.ChangelogFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	if filter.Format == "" {
+		filter.Format = models.ChangelogFormatMarkdown
+	}
+
+	if filter.GroupBy == "" {
+		filter.GroupBy = changelog.GroupByType
+	}
+
+	ownerName, repoName := chi.URLParam(r, "ownerName"), chi.URLParam(r, "repoName")
+	list, err := a.commitsRPC.ListCommitsBetweenRefs(r.Context(), &commits.CommitRangeParams{
+		OwnerName: ownerName,
+		RepoName:  repoName,
+		From:      filter.From,
+		To:        filter.To,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch commits between refs")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	notes := changelog.Build(list.Data, filter.GroupBy)
+	notes.OwnerName, notes.RepoName, notes.From, notes.To = ownerName, repoName, filter.From, filter.To
+	if filter.Format == models.ChangelogFormatJSON {
+		utils.WriteHTTPSuccess(w, "Successfully generated changelog", notes)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err = notes.WriteMarkdown(w); err != nil {
+		useLogger.WithError(err).Error("failed to write changelog")
+	}
+}
+
+// getRepoStatsHistory returns the star, fork, open issue and watcher counts the repo manager recorded
+// on every refresh of the repo, keeping the last snapshot of every day, week or month.
+func (a API) getRepoStatsHistory(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "getRepoStatsHistory").Logger
+	var filter models.RepoStatsHistoryFilters
+	err := decodeQuery(r.URL.Query(), &filter)
+	if err == nil {
+		err = filter.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	if filter.Interval == "" {
+		filter.Interval = models.IntervalDay
+	}
+
+	params := &gitRepos.RepoStatsHistoryRequest{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		Interval:  filter.Interval,
+	}
+
+	if filter.FromDate != nil {
+		params.FromDate = filter.FromDate.String()
+	}
+
+	if filter.ToDate != nil {
+		params.ToDate = filter.ToDate.String()
+	}
+
+	history, err := a.reposRPC.GetRepoStatsHistory(r.Context(), params)
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch repo stats history")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved repo stats history", history.Data)
+}
+
+// listRepoEvents returns the renames, transfers, archivals and deletions the repo manager noticed
+// upstream for a repo, the names may be the ones the repo went by before.
+func (a API) listRepoEvents(w http.ResponseWriter, r *http.Request) {
+	useLogger := a.logger.WithContext(r.Context()).WithField("endpointName", "listRepoEvents").Logger
+	var page models.Pagination
+	err := decodeQuery(r.URL.Query(), &page)
+	if err == nil {
+		err = page.Validate()
+	}
+
+	if err != nil {
+		useLogger.WithError(err).Error("invalid query params.")
+		utils.WriteHTTPValidationError(w, err)
+		return
+	}
+
+	list, err := a.reposRPC.ListRepoEvents(r.Context(), &gitRepos.ListRepoEventsRequest{
+		OwnerName: chi.URLParam(r, "ownerName"),
+		RepoName:  chi.URLParam(r, "repoName"),
+		Page:      page.Page,
+		Limit:     page.Limit,
+	})
+	if err != nil {
+		useLogger.WithError(err).Error("failed to fetch list of repo events")
+		utils.WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.WriteHTTPSuccess(w, "Successfully retrieved list of repo events", list.Data)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"gitbeam/models"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gorilla/schema"
+	"io"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+var (
+	errInvalidInteger = errors.New("must be an integer")
+	errInvalidBool    = errors.New("must be true or false")
+	errInvalidValue   = errors.New("is invalid")
+	errUnknownParam   = errors.New("is not a known parameter")
+)
+
+// decodeQuery decodes the query params into target, answering values that don't convert to their
+// field, and unknown params, with field errors.
+func decodeQuery(query url.Values, target any) error {
+	err := schema.NewDecoder().Decode(target, query)
+
+	var multiError schema.MultiError
+	if !errors.As(err, &multiError) {
+		return err
+	}
+
+	fieldErrors := validation.Errors{}
+	for key, err := range multiError {
+		var conversionError schema.ConversionError
+		var unknownKeyError schema.UnknownKeyError
+		switch {
+		case errors.As(err, &conversionError):
+			fieldErrors[conversionError.Key] = conversionFieldError(conversionError)
+		case errors.As(err, &unknownKeyError):
+			fieldErrors[unknownKeyError.Key] = errUnknownParam
+		default:
+			fieldErrors[key] = errInvalidValue
+		}
+	}
+
+	return fieldErrors
+}
+
+func conversionFieldError(err schema.ConversionError) error {
+	var parseError *time.ParseError
+	if errors.As(err.Err, &parseError) {
+		return models.ErrInvalidDate
+	}
+
+	if err.Type == nil {
+		return errInvalidValue
+	}
+
+	switch err.Type.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return errInvalidInteger
+	case reflect.Bool:
+		return errInvalidBool
+	}
+
+	return errInvalidValue
+}
+
+// decodeJSON decodes the JSON body into target, answering values of the wrong type, and dates that
+// don't parse, with field errors. Malformed bodies stay plain errors.
+func decodeJSON(body io.Reader, target any) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(data, target); err == nil {
+		return nil
+	}
+
+	// The errors of encoding/json don't reliably tell the field, the ones of UnmarshalJSON methods never
+	// do, so decode the fields one at a time into a blank target to find the ones that fail.
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil {
+		return err
+	}
+
+	fieldErrors := validation.Errors{}
+	for field, raw := range fields {
+		single, _ := json.Marshal(map[string]json.RawMessage{field: raw})
+		blank := reflect.New(reflect.TypeOf(target).Elem()).Interface()
+		var dateError *models.DateError
+		var typeError *json.UnmarshalTypeError
+		switch fieldErr := json.Unmarshal(single, blank); {
+		case errors.As(fieldErr, &dateError):
+			fieldErrors[field] = models.ErrInvalidDate
+		case errors.As(fieldErr, &typeError):
+			fieldErrors[field] = errInvalidValue
+		}
+	}
+
+	if len(fieldErrors) == 0 {
+		return err
+	}
+
+	return fieldErrors
+}
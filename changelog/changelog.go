@@ -0,0 +1,215 @@
+package changelog
+
+import (
+	"fmt"
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	GroupByType = models.ChangelogGroupByType
+	// GroupByTrailerPrefix groups commits by the values of a trailer, e.g. trailer.Bug.
+	GroupByTrailerPrefix = models.ChangelogGroupByTrailerPrefix
+
+	otherChanges = "Other Changes"
+)
+
+// typeTitles are the section titles of the conventional commit types, in the order they are rendered.
+var typeTitles = []struct {
+	commitType string
+	title      string
+}{
+	{"feat", "Features"},
+	{"fix", "Bug Fixes"},
+	{"perf", "Performance Improvements"},
+	{"revert", "Reverts"},
+	{"refactor", "Code Refactoring"},
+	{"docs", "Documentation"},
+	{"style", "Styles"},
+	{"test", "Tests"},
+	{"build", "Build System"},
+	{"ci", "Continuous Integration"},
+	{"chore", "Chores"},
+}
+
+var conventionalHeader = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^)]*)\))?(!)?: (.+)$`)
+
+// ParseConventionalCommit parses the message of a commit (https://www.conventionalcommits.org), ok is false
+// when it doesn't follow conventional commits. Types are case-insensitive and returned lowercased, any type
+// is accepted, the ones missing from typeTitles being rendered as other changes.
+func ParseConventionalCommit(message string) (cc models.ConventionalCommit, ok bool) {
+	subject, body, _ := strings.Cut(message, "\n")
+	matches := conventionalHeader.FindStringSubmatch(strings.TrimSpace(subject))
+	if matches == nil {
+		return cc, false
+	}
+
+	cc = models.ConventionalCommit{
+		Type:        strings.ToLower(matches[1]),
+		Scope:       matches[2],
+		Breaking:    matches[3] == "!",
+		Description: matches[4],
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			cc.Breaking = true
+		}
+	}
+
+	return cc, true
+}
+
+type Entry struct {
+	SHA      string `json:"sha"`
+	Subject  string `json:"subject"`
+	Scope    string `json:"scope,omitempty"`
+	Author   string `json:"author"`
+	URL      string `json:"url"`
+	Breaking bool   `json:"breaking"`
+}
+
+type Section struct {
+	Title   string  `json:"title"`
+	Entries []Entry `json:"entries"`
+}
+
+type Changelog struct {
+	OwnerName string    `json:"ownerName"`
+	RepoName  string    `json:"repoName"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Sections  []Section `json:"sections"`
+}
+
+// Build groups the commits into sections, either by conventional commit type or by the values of a
+// trailer ( groupBy of trailer.<Key> ). Commits that don't fit any group end up in "Other Changes".
+func Build(list []*commits.Commit, groupBy string) Changelog {
+	var changelog Changelog
+	sections := make(map[string][]Entry)
+	var titles []string
+	add := func(title string, entry Entry) {
+		if _, ok := sections[title]; !ok {
+			titles = append(titles, title)
+		}
+		sections[title] = append(sections[title], entry)
+	}
+
+	trailerKey, byTrailer := strings.CutPrefix(groupBy, GroupByTrailerPrefix)
+	for _, commit := range list {
+		subject, _, _ := strings.Cut(commit.GetMessage(), "\n")
+		entry := Entry{
+			SHA:     commit.GetSha(),
+			Subject: strings.TrimSpace(subject),
+			Author:  commit.GetAuthor(),
+			URL:     commit.GetUrl(),
+		}
+
+		// Prefer the breakdown stored by the commit monitor. Commits of other types, e.g. "HistoryBackend:
+		// Drop ...", keep their whole subject under other changes.
+		cc, isConventional := ParseConventionalCommit(commit.GetMessage())
+		if stored := commit.GetConventional(); stored != nil {
+			cc, isConventional = models.ConventionalCommit{
+				Type:        strings.ToLower(stored.GetType()),
+				Scope:       stored.GetScope(),
+				Description: stored.GetDescription(),
+				Breaking:    stored.GetBreaking(),
+			}, true
+		}
+
+		if isConventional && typeTitle(cc.Type) != otherChanges {
+			entry.Subject = cc.Description
+			entry.Scope = cc.Scope
+			entry.Breaking = cc.Breaking
+		}
+
+		if byTrailer {
+			grouped := false
+			for _, trailer := range commit.GetTrailers() {
+				if strings.EqualFold(trailer.GetKey(), trailerKey) {
+					add(fmt.Sprintf("%s: %s", trailer.GetKey(), trailer.GetValue()), entry)
+					grouped = true
+				}
+			}
+			if !grouped {
+				add(otherChanges, entry)
+			}
+			continue
+		}
+
+		add(typeTitle(cc.Type), entry)
+	}
+
+	sort.SliceStable(titles, func(i, j int) bool {
+		return sectionOrder(titles[i]) < sectionOrder(titles[j])
+	})
+
+	for _, title := range titles {
+		changelog.Sections = append(changelog.Sections, Section{Title: title, Entries: sections[title]})
+	}
+
+	return changelog
+}
+
+// WriteMarkdown renders the changelog as markdown, linking every entry to its commit.
+func (c Changelog) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s/%s %s...%s\n", c.OwnerName, c.RepoName, c.From, c.To)
+	for _, section := range c.Sections {
+		fmt.Fprintf(&b, "\n### %s\n\n", section.Title)
+		for _, entry := range section.Entries {
+			b.WriteString("- ")
+			if entry.Breaking {
+				b.WriteString("**BREAKING:** ")
+			}
+			if entry.Scope != "" {
+				fmt.Fprintf(&b, "**%s:** ", entry.Scope)
+			}
+			fmt.Fprintf(&b, "%s ([%s](%s))", entry.Subject, shortSHA(entry.SHA), entry.URL)
+			if entry.Author != "" {
+				fmt.Fprintf(&b, " by %s", entry.Author)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func typeTitle(commitType string) string {
+	for _, t := range typeTitles {
+		if t.commitType == commitType {
+			return t.title
+		}
+	}
+
+	return otherChanges
+}
+
+// sectionOrder sorts the conventional commit sections in typeTitles order, and other changes last.
+func sectionOrder(title string) int {
+	for i, t := range typeTitles {
+		if t.title == title {
+			return i
+		}
+	}
+
+	if title == otherChanges {
+		return len(typeTitles) + 1
+	}
+
+	return len(typeTitles)
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+
+	return sha
+}
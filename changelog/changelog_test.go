@@ -0,0 +1,79 @@
+package changelog
+
+import (
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	cc, ok := ParseConventionalCommit("feat(api)!: drop the v1 routes\n\nThe v1 routes were deprecated a year ago.")
+	assert.True(t, ok)
+	assert.Equal(t, models.ConventionalCommit{Type: "feat", Scope: "api", Description: "drop the v1 routes", Breaking: true}, cc)
+
+	cc, ok = ParseConventionalCommit("fix: handle empty pages\n\nBREAKING CHANGE: page now starts at 1")
+	assert.True(t, ok)
+	assert.Equal(t, "fix", cc.Type)
+	assert.Empty(t, cc.Scope)
+	assert.True(t, cc.Breaking)
+
+	// Types are case-insensitive and open-ended.
+	for message, commitType := range map[string]string{
+		"Feat: add changelogs":                             "feat",
+		"FIX(sync): stop crashing on shutdown":             "fix",
+		"wip: add changelogs":                              "wip",
+		"HistoryBackend: Drop HistoryDBTasks in Closing()": "historybackend",
+	} {
+		cc, ok = ParseConventionalCommit(message)
+		assert.True(t, ok, message)
+		assert.Equal(t, commitType, cc.Type, message)
+	}
+
+	for _, message := range []string{
+		"Roll Skia from 1a2b3c to 4d5e6f (3 revisions)",
+		"[sync] stop crashing on shutdown",
+		"feat:missing space",
+	} {
+		_, ok = ParseConventionalCommit(message)
+		assert.False(t, ok, message)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	list := []*commits.Commit{
+		{Sha: "aaaaaaaaaa", Message: "fix(sync): stop crashing on shutdown", Url: "https://github.com/o/r/commit/aaaaaaaaaa",
+			Trailers: []*commits.Trailer{{Key: "Bug", Value: "1"}}},
+		{Sha: "bbbbbbbbbb", Message: "HistoryBackend: Drop HistoryDBTasks in Closing()", Url: "https://github.com/o/r/commit/bbbbbbbbbb"},
+		{Sha: "cccccccccc", Message: "feat: add changelogs\n\nBug: 2", Url: "https://github.com/o/r/commit/cccccccccc",
+			Trailers: []*commits.Trailer{{Key: "Bug", Value: "2"}}, Author: "Marc Treib"},
+		{Sha: "eeeeeeeeee", Message: "Feat: group changelogs by trailer", Url: "https://github.com/o/r/commit/eeeeeeeeee"},
+	}
+
+	byType := Build(list, GroupByType)
+	assert.Len(t, byType.Sections, 3)
+	assert.Equal(t, "Features", byType.Sections[0].Title)
+	assert.Equal(t, "group changelogs by trailer", byType.Sections[0].Entries[1].Subject)
+	assert.Equal(t, "Bug Fixes", byType.Sections[1].Title)
+	assert.Equal(t, "sync", byType.Sections[1].Entries[0].Scope)
+	assert.Equal(t, "Other Changes", byType.Sections[2].Title)
+	assert.Equal(t, "HistoryBackend: Drop HistoryDBTasks in Closing()", byType.Sections[2].Entries[0].Subject)
+
+	byBug := Build(list, "trailer.Bug")
+	assert.Len(t, byBug.Sections, 3)
+	assert.Equal(t, "Bug: 1", byBug.Sections[0].Title)
+	assert.Equal(t, "Bug: 2", byBug.Sections[1].Title)
+	assert.Equal(t, "Other Changes", byBug.Sections[2].Title)
+
+	stored := Build([]*commits.Commit{
+		{Sha: "dddddddddd", Message: "Add changelogs", Conventional: &commits.ConventionalCommit{Type: "feat", Description: "add changelogs"}},
+	}, GroupByType)
+	assert.Equal(t, "Features", stored.Sections[0].Title)
+	assert.Equal(t, "add changelogs", stored.Sections[0].Entries[0].Subject)
+
+	var markdown strings.Builder
+	assert.Nil(t, byType.WriteMarkdown(&markdown))
+	assert.Contains(t, markdown.String(), "### Features\n\n- add changelogs ([ccccccc](https://github.com/o/r/commit/cccccccccc)) by Marc Treib\n")
+	assert.Contains(t, markdown.String(), "- **sync:** stop crashing on shutdown")
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gitbeam/models"
+	"github.com/gorilla/schema"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 2
+	DefaultBackoff    = 200 * time.Millisecond
+)
+
+// Client calls the gitbeam gateway over its HTTP API.
+type Client struct {
+	baseURL    string
+	token      string
+	maxRetries int
+	backoff    time.Duration
+	httpClient *http.Client
+	encoder    *schema.Encoder
+}
+
+type Option func(*Client)
+
+// WithToken sends token as the bearer token of every request, for gateways behind an authenticating proxy.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetries retries GET, PUT and DELETE requests up to maxRetries times when the gateway can't be
+// reached or is temporarily unavailable, waiting backoff before the first retry and twice as long before
+// every next one. Defaults to DefaultMaxRetries and DefaultBackoff, WithRetries(0, 0) turns retries off.
+func WithRetries(maxRetries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries, c.backoff = maxRetries, backoff
+	}
+}
+
+// WithHTTPClient sets the http.Client requests are sent with, http.DefaultClient is used otherwise.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// New returns a Client of the gateway at baseURL, e.g. http://localhost:8080.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		maxRetries: DefaultMaxRetries,
+		backoff:    DefaultBackoff,
+		httpClient: http.DefaultClient,
+		encoder:    schema.NewEncoder(),
+	}
+
+	c.encoder.RegisterEncoder(&models.Date{}, func(v reflect.Value) string {
+		if v.IsNil() {
+			return ""
+		}
+
+		return v.Interface().(*models.Date).Format(time.DateOnly)
+	})
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// query encodes filters the way the gateway decodes them, leaving out empty parameters.
+func (c *Client) query(filters any) (url.Values, error) {
+	query := url.Values{}
+	if filters == nil {
+		return query, nil
+	}
+
+	if err := c.encoder.Encode(filters, query); err != nil {
+		return nil, err
+	}
+
+	for key, values := range query {
+		if len(values) == 1 && values[0] == "" {
+			query.Del(key)
+		}
+	}
+
+	return query, nil
+}
+
+// open sends the request and returns the response when it is successful, the caller closes its body.
+func (c *Client) open(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		res, err := c.send(ctx, method, endpoint, payload)
+		if err == nil || attempt >= c.maxRetries || !retryable(method, err) {
+			return res, err
+		}
+
+		wait := backoff
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+			wait = apiErr.RetryAfter
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, endpoint string, payload []byte) (*http.Response, error) {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		defer res.Body.Close()
+		return nil, decodeError(res)
+	}
+
+	return res, nil
+}
+
+// do sends the request and decodes the data of the result envelope into out, when out isn't nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	res, err := c.open(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	result := models.Result{Data: out}
+	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return fmt.Errorf("gitbeam: invalid response: %w", err)
+	}
+
+	if !result.Success {
+		return &Error{StatusCode: res.StatusCode, Message: result.Message}
+	}
+
+	return nil
+}
+
+// retryable tells whether a request failing with err may be sent again. Only the methods that are
+// idempotent are, since a request may fail after the gateway acted on it.
+func retryable(method string, err error) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+func repoPath(ownerName, repoName string) string {
+	return url.PathEscape(ownerName) + "/" + url.PathEscape(repoName)
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"gitbeam/api"
+	"gitbeam/api/pb/commits"
+	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/mocks"
+	"gitbeam/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newGateway(t *testing.T, commitsRPC commits.GitBeamCommitsServiceClient, reposRPC gitRepos.GitBeamRepositoryServiceClient) *httptest.Server {
+	router := chi.NewMux()
+	api.New(commitsRPC, reposRPC, logrus.New()).Routes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestListCommits(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockCommitsRPC.EXPECT().ListCommits(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+		func(_ context.Context, in *commits.CommitFilterParams, _ ...grpc.CallOption) (*commits.ListCommitResponse, error) {
+			assert.Equal(t, "chromium", in.GetOwnerName())
+			assert.Equal(t, "main", in.GetBranch())
+			assert.Equal(t, "2024-07-01", in.GetFromDate())
+			assert.Equal(t, map[string]string{"Bug": "b/1234"}, in.GetTrailers())
+			assert.Equal(t, int64(2), in.GetLimit())
+
+			// Two full pages, then the last one.
+			page := max(in.GetPage(), 1)
+			if page == 3 {
+				return &commits.ListCommitResponse{Data: []*commits.Commit{{Sha: "5"}}}, nil
+			}
+
+			return &commits.ListCommitResponse{Data: []*commits.Commit{
+				{Sha: strconv.FormatInt(2*page-1, 10)},
+				{Sha: strconv.FormatInt(2*page, 10)},
+			}}, nil
+		})
+
+	gateway := newGateway(t, mockCommitsRPC, nil)
+	fromDate, _ := models.Parse("2024-07-01")
+	filter := models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "chromium", RepoName: "chromium"},
+		Limit:            2,
+		FromDate:         fromDate,
+		Branch:           "main",
+		Trailers:         map[string]string{"Bug": "b/1234"},
+	}
+
+	list, err := New(gateway.URL).ListCommits(context.Background(), filter)
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "1", list[0].SHA)
+
+	var shas []string
+	it := New(gateway.URL).Commits(models.CommitFilters{
+		OwnerAndRepoName: filter.OwnerAndRepoName,
+		Limit:            2,
+		Page:             2,
+		FromDate:         fromDate,
+		Branch:           "main",
+		Trailers:         filter.Trailers,
+	})
+	for it.Next(context.Background()) {
+		shas = append(shas, it.Value().SHA)
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"3", "4", "5"}, shas)
+}
+
+func TestListTopAuthors(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockCommitsRPC.EXPECT().ListTopCommitAuthor(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Limit:     2,
+	}).Times(1).Return(&commits.ListTopCommitAuthorResponse{Data: []*commits.TopCommitAuthor{
+		{Author: "Marc Treib", Email: "treib@chromium.org", CommitsCount: 42, Rank: 1},
+		{Author: "Jan Wilken", Email: "jdoerrie@chromium.org", CommitsCount: 7, Rank: 2},
+	}}, nil)
+
+	gateway := newGateway(t, mockCommitsRPC, nil)
+	list, err := New(gateway.URL).ListTopAuthors(context.Background(), models.CommitFilters{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "chromium", RepoName: "chromium"},
+		Limit:            2,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "Marc Treib", list[0].Author)
+	assert.Equal(t, 42, list[0].CommitsCount)
+	assert.Equal(t, 7, list[1].CommitsCount)
+
+	mockCommitsRPC.EXPECT().ListTopCommitAuthor(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName:  "chromium",
+		RepoName:   "chromium",
+		WindowDays: 30,
+	}).Times(1).Return(&commits.ListTopCommitAuthorResponse{
+		Data:          []*commits.TopCommitAuthor{{Author: "Marc Treib", CommitsCount: 42, PreviousCommitsCount: 30, RankDelta: 1}},
+		CurrentWindow: &commits.DateWindow{FromDate: "2024-06-23", ToDate: "2024-07-23"},
+	}, nil)
+
+	trends, err := New(gateway.URL).ListTopAuthorTrends(context.Background(), models.TopAuthorTrendsFilters{
+		CommitFilters: models.CommitFilters{OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "chromium", RepoName: "chromium"}},
+		WindowDays:    30,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 42, trends.Data[0].CommitsCount)
+	assert.Equal(t, 30, trends.Data[0].PreviousCommitsCount)
+	assert.Equal(t, "2024-06-23", trends.CurrentWindow.FromDate)
+}
+
+func TestErrors(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), gomock.Any()).Times(2).Return(nil, errors.New("repo not found"))
+
+	gateway := newGateway(t, nil, mockRepoRPC)
+	c := New(gateway.URL)
+
+	_, err := c.GetRepo(context.Background(), "brave", "brave-browsr")
+	assert.ErrorIs(t, err, ErrNotFound)
+	var apiErr *Error
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "repo not found", apiErr.Message)
+
+	fromDate, _ := models.Parse("2024-07-01")
+	err = c.StartMonitoring(context.Background(), models.MirrorRepoCommitsRequest{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "brave", RepoName: "brave-browsr"},
+		FromDate:         fromDate,
+	})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.NotErrorIs(t, err, ErrNotFound)
+
+	// Invalid fields are rejected before any RPC, with the error of each field.
+	toDate, _ := models.Parse("2024-06-01")
+	err = c.StartMonitoring(context.Background(), models.MirrorRepoCommitsRequest{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: "brave", RepoName: "brave-browsr"},
+		FromDate:         fromDate,
+		ToDate:           toDate,
+	})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	assert.Equal(t, map[string]any{"fromDate": "must not be after toDate"}, apiErr.Fields)
+
+	// Errors that didn't come from the gateway still carry their status.
+	_, err = New(gateway.URL+"/nowhere").ListRepos(context.Background(), models.RepoFilters{})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRetriesAndToken(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Return(&gitRepos.Repo{Owner: "chromium", Name: "chromium"}, nil)
+
+	router := chi.NewMux()
+	api.New(nil, mockRepoRPC, logrus.New()).Routes(router)
+
+	// A proxy in front of the gateway that checks the token, and is unavailable to the first two requests.
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		router.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	c := New(server.URL, WithToken("s3cr3t"), WithRetries(2, time.Millisecond))
+	repo, err := c.GetRepo(context.Background(), "chromium", "chromium")
+	assert.Nil(t, err)
+	assert.Equal(t, "chromium", repo.Name)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// POSTs aren't retried, the gateway may have acted on them.
+	calls.Store(0)
+	_, err = c.RefreshRepo(context.Background(), "chromium", "chromium")
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, int32(1), calls.Load())
+
+	_, err = New(server.URL, WithRetries(0, 0)).GetRepo(context.Background(), "chromium", "chromium")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
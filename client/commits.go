@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"gitbeam/manifest"
+	"gitbeam/models"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) ListCommits(ctx context.Context, filter models.CommitFilters) ([]models.Commit, error) {
+	query, err := c.commitsQuery(filter, filter.Trailers)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []models.Commit
+	return list, c.do(ctx, http.MethodGet, "/commits", query, nil, &list)
+}
+
+// Commits iterates through every commit matching filter, starting at its page.
+func (c *Client) Commits(filter models.CommitFilters) *Iterator[models.Commit] {
+	return newIterator(filter.Page, filter.Limit, func(ctx context.Context, page, limit int64) ([]models.Commit, error) {
+		filter.Page, filter.Limit = page, limit
+		return c.ListCommits(ctx, filter)
+	})
+}
+
+func (c *Client) GetCommit(ctx context.Context, ownerName, repoName, sha string) (models.Commit, error) {
+	var commit models.Commit
+	path := "/commits/" + repoPath(ownerName, repoName) + "/" + url.PathEscape(sha)
+	return commit, c.do(ctx, http.MethodGet, path, nil, nil, &commit)
+}
+
+func (c *Client) SearchCommits(ctx context.Context, filter models.CommitSearchFilters) ([]models.CommitSearchResult, error) {
+	query, err := c.commitsQuery(filter, filter.Trailers)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []models.CommitSearchResult
+	return list, c.do(ctx, http.MethodGet, "/commits/search", query, nil, &list)
+}
+
+// SearchResults iterates through every result of the search, best ranked first.
+func (c *Client) SearchResults(filter models.CommitSearchFilters) *Iterator[models.CommitSearchResult] {
+	return newIterator(filter.Page, filter.Limit, func(ctx context.Context, page, limit int64) ([]models.CommitSearchResult, error) {
+		filter.Page, filter.Limit = page, limit
+		return c.SearchCommits(ctx, filter)
+	})
+}
+
+func (c *Client) GetCommitActivity(ctx context.Context, filter models.CommitActivityFilters) (models.CommitActivity, error) {
+	var activity models.CommitActivity
+	query, err := c.commitsQuery(filter, filter.Trailers)
+	if err != nil {
+		return activity, err
+	}
+
+	return activity, c.do(ctx, http.MethodGet, "/commits/activity", query, nil, &activity)
+}
+
+// ExportCommits streams the export of the commits matching filter, the caller closes it.
+func (c *Client) ExportCommits(ctx context.Context, filter models.CommitExportFilters) (io.ReadCloser, error) {
+	query, err := c.commitsQuery(filter, filter.Trailers)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.open(ctx, http.MethodGet, "/commits/export", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (c *Client) ListTopAuthors(ctx context.Context, filter models.CommitFilters) ([]models.TopCommitAuthor, error) {
+	query, err := c.commitsQuery(filter, filter.Trailers)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []models.TopCommitAuthor
+	return list, c.do(ctx, http.MethodGet, "/commits/top-authors", query, nil, &list)
+}
+
+// ListTopAuthorTrends splits the top authors leaderboard into weeks or months, or ranks the last
+// windowDays days against the ones before them. One of groupBy and windowDays is required, without them
+// the gateway answers the flat leaderboard of ListTopAuthors.
+func (c *Client) ListTopAuthorTrends(ctx context.Context, filter models.TopAuthorTrendsFilters) (models.TopCommitAuthorTrends, error) {
+	var trends models.TopCommitAuthorTrends
+	if !filter.Trends() {
+		return trends, fmt.Errorf("%w: one of groupBy and windowDays is required", ErrBadRequest)
+	}
+
+	query, err := c.commitsQuery(filter, filter.Trailers)
+	if err != nil {
+		return trends, err
+	}
+
+	return trends, c.do(ctx, http.MethodGet, "/commits/top-authors", query, nil, &trends)
+}
+
+func (c *Client) StartMonitoring(ctx context.Context, config models.MirrorRepoCommitsRequest) error {
+	return c.do(ctx, http.MethodPost, "/commits/start-monitoring", nil, config, nil)
+}
+
+func (c *Client) StopMonitoring(ctx context.Context, repo models.OwnerAndRepoName) error {
+	return c.do(ctx, http.MethodPost, "/commits/stop-monitoring", nil, repo, nil)
+}
+
+// BulkMonitoring starts monitoring every repo of the manifest, a repo failing doesn't fail the others
+// but is reported in the returned report.
+func (c *Client) BulkMonitoring(ctx context.Context, m manifest.Manifest, filter models.BulkMonitoringFilters) (manifest.Report, error) {
+	var report manifest.Report
+	query, err := c.query(filter)
+	if err != nil {
+		return report, err
+	}
+
+	return report, c.do(ctx, http.MethodPost, "/commits/bulk-monitoring", query, m, &report)
+}
+
+// GetAuthorAliases returns the author aliases of the repo, or of the owner when repoName is empty.
+func (c *Client) GetAuthorAliases(ctx context.Context, scope models.OwnerAndRepoName) (models.AuthorAliasesConfig, error) {
+	var config models.AuthorAliasesConfig
+	query, err := c.query(scope)
+	if err != nil {
+		return config, err
+	}
+
+	return config, c.do(ctx, http.MethodGet, "/authors/aliases", query, nil, &config)
+}
+
+func (c *Client) SetAuthorAliases(ctx context.Context, config models.AuthorAliasesConfig) (models.AuthorAliasesConfig, error) {
+	var updated models.AuthorAliasesConfig
+	return updated, c.do(ctx, http.MethodPut, "/authors/aliases", nil, config, &updated)
+}
+
+func (c *Client) GetReconcileStatus(ctx context.Context) (manifest.Status, error) {
+	var status manifest.Status
+	return status, c.do(ctx, http.MethodGet, "/admin/reconcile-status", nil, nil, &status)
+}
+
+// commitsQuery encodes commit filters, trailers as trailer.<Key> parameters.
+func (c *Client) commitsQuery(filter any, trailers map[string]string) (url.Values, error) {
+	query, err := c.query(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range trailers {
+		query.Set("trailer."+key, value)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gitbeam/models"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The gateway answers errors with a status code and a message, check them with errors.Is, e.g.
+//
+//	if errors.Is(err, client.ErrNotFound) {
+var (
+	ErrBadRequest   = errors.New("gitbeam: bad request")
+	ErrUnauthorized = errors.New("gitbeam: unauthorized")
+	ErrNotFound     = errors.New("gitbeam: not found")
+	ErrRateLimited  = errors.New("gitbeam: rate limited")
+	ErrUnavailable  = errors.New("gitbeam: unavailable")
+)
+
+// Error is returned when the gateway answers with an error envelope.
+type Error struct {
+	StatusCode int
+	Message    string
+	// RetryAfter is how long the gateway asked to wait before sending the request again, if it did.
+	RetryAfter time.Duration
+	// Fields holds the error of each invalid field when the gateway answered 422, keyed by the name
+	// of the field, e.g. {"fromDate": "must not be after toDate"}.
+	Fields map[string]any
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("gitbeam: %d %s", e.StatusCode, e.Message)
+}
+
+// Is maps the status code of the error onto the sentinel errors of the package.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnavailable:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// Temporary tells whether the request may succeed if it is sent again.
+func (e *Error) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// decodeError reads the error envelope of the response, falling back on its status text when the body
+// isn't one, e.g. when a proxy in front of the gateway answered.
+func decodeError(res *http.Response) error {
+	apiErr := &Error{StatusCode: res.StatusCode, Message: http.StatusText(res.StatusCode)}
+	if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+		apiErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	result := models.Result{Data: &apiErr.Fields}
+	if err := json.NewDecoder(res.Body).Decode(&result); err == nil && result.Message != "" {
+		apiErr.Message = result.Message
+	}
+
+	return apiErr
+}
@@ -0,0 +1,79 @@
+package client
+
+import "context"
+
+// DefaultPageSize is how many results iterators fetch at a time when the filters don't set a limit.
+const DefaultPageSize = 100
+
+// Iterator walks through the results of a paginated endpoint, fetching them a page at a time.
+//
+//	it := c.Commits(filter)
+//	for it.Next(ctx) {
+//		commit := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+type Iterator[T any] struct {
+	fetch   func(ctx context.Context, page, limit int64) ([]T, error)
+	page    int64
+	limit   int64
+	items   []T
+	current T
+	done    bool
+	err     error
+}
+
+// newIterator starts at page, fetching limit results at a time.
+func newIterator[T any](page, limit int64, fetch func(ctx context.Context, page, limit int64) ([]T, error)) *Iterator[T] {
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	return &Iterator[T]{fetch: fetch, page: page, limit: limit}
+}
+
+// Next advances to the next result, fetching the next page when needed. It returns false once the
+// results are exhausted or fetching a page failed, Err tells which.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		items, err := it.fetch(ctx, it.page, it.limit)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page++
+		it.items = items
+		it.done = int64(len(items)) < it.limit
+	}
+
+	it.current, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Value returns the result Next advanced to.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error fetching a page failed with, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All drains the iterator into a slice.
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for it.Next(ctx) {
+		all = append(all, it.Value())
+	}
+
+	return all, it.Err()
+}
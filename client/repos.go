@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"gitbeam/models"
+	"net/http"
+)
+
+func (c *Client) ListRepos(ctx context.Context, filter models.RepoFilters) ([]models.Repo, error) {
+	query, err := c.query(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []models.Repo
+	return repos, c.do(ctx, http.MethodGet, "/repos", query, nil, &repos)
+}
+
+// Repos iterates through every repo matching filter, starting at its page.
+func (c *Client) Repos(filter models.RepoFilters) *Iterator[models.Repo] {
+	return newIterator(filter.Page, filter.Limit, func(ctx context.Context, page, limit int64) ([]models.Repo, error) {
+		filter.Page, filter.Limit = page, limit
+		return c.ListRepos(ctx, filter)
+	})
+}
+
+func (c *Client) GetRepo(ctx context.Context, ownerName, repoName string) (models.Repo, error) {
+	var repo models.Repo
+	return repo, c.do(ctx, http.MethodGet, "/repos/"+repoPath(ownerName, repoName), nil, nil, &repo)
+}
+
+// RegisterRepo registers a repo by its owner and repo name or by its GitHub URL, registering a known
+// repo refreshes it.
+func (c *Client) RegisterRepo(ctx context.Context, payload models.RegisterRepoRequest) (models.Repo, error) {
+	var repo models.Repo
+	return repo, c.do(ctx, http.MethodPost, "/repos", nil, payload, &repo)
+}
+
+func (c *Client) RefreshRepo(ctx context.Context, ownerName, repoName string) (models.Repo, error) {
+	var repo models.Repo
+	return repo, c.do(ctx, http.MethodPost, "/repos/"+repoPath(ownerName, repoName)+"/refresh", nil, nil, &repo)
+}
+
+// DeleteRepo stops monitoring the commits of the repo and removes it.
+func (c *Client) DeleteRepo(ctx context.Context, ownerName, repoName string, filter models.DeleteRepoFilters) error {
+	query, err := c.query(filter)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, http.MethodDelete, "/repos/"+repoPath(ownerName, repoName), query, nil, nil)
+}
+
+func (c *Client) ListRepoEvents(ctx context.Context, ownerName, repoName string, page models.Pagination) ([]models.RepoEvent, error) {
+	var list []models.RepoEvent
+	return list, c.listRepoResource(ctx, ownerName, repoName, "/events", page, &list)
+}
+
+func (c *Client) RepoEvents(ownerName, repoName string, page models.Pagination) *Iterator[models.RepoEvent] {
+	return newIterator(page.Page, page.Limit, func(ctx context.Context, page, limit int64) ([]models.RepoEvent, error) {
+		return c.ListRepoEvents(ctx, ownerName, repoName, models.Pagination{Page: page, Limit: limit})
+	})
+}
+
+func (c *Client) ListTags(ctx context.Context, ownerName, repoName string, page models.Pagination) ([]models.Tag, error) {
+	var list []models.Tag
+	return list, c.listRepoResource(ctx, ownerName, repoName, "/tags", page, &list)
+}
+
+func (c *Client) Tags(ownerName, repoName string, page models.Pagination) *Iterator[models.Tag] {
+	return newIterator(page.Page, page.Limit, func(ctx context.Context, page, limit int64) ([]models.Tag, error) {
+		return c.ListTags(ctx, ownerName, repoName, models.Pagination{Page: page, Limit: limit})
+	})
+}
+
+func (c *Client) ListReleases(ctx context.Context, ownerName, repoName string, page models.Pagination) ([]models.Release, error) {
+	var list []models.Release
+	return list, c.listRepoResource(ctx, ownerName, repoName, "/releases", page, &list)
+}
+
+func (c *Client) Releases(ownerName, repoName string, page models.Pagination) *Iterator[models.Release] {
+	return newIterator(page.Page, page.Limit, func(ctx context.Context, page, limit int64) ([]models.Release, error) {
+		return c.ListReleases(ctx, ownerName, repoName, models.Pagination{Page: page, Limit: limit})
+	})
+}
+
+func (c *Client) GetRepoStatsHistory(ctx context.Context, ownerName, repoName string, filter models.RepoStatsHistoryFilters) ([]models.RepoStatsSnapshot, error) {
+	query, err := c.query(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []models.RepoStatsSnapshot
+	return history, c.do(ctx, http.MethodGet, "/repos/"+repoPath(ownerName, repoName)+"/stats/history", query, nil, &history)
+}
+
+func (c *Client) listRepoResource(ctx context.Context, ownerName, repoName, resource string, page models.Pagination, out any) error {
+	query, err := c.query(page)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, http.MethodGet, "/repos/"+repoPath(ownerName, repoName)+resource, query, nil, out)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"gitbeam/models"
+	"strconv"
+)
+
+var authorsCommands = map[string]command{
+	"top": {
+		usage:   "[OWNER/REPO] [--owner O] [--from DATE] [--to DATE] [--limit N]",
+		summary: "rank the authors by number of commits",
+		run: func(ctx context.Context, e *env, args []string) error {
+			var filter models.CommitFilters
+			var ownerName string
+			fs := e.flags("authors top")
+			commitFilterFlags(fs, &filter)
+			fs.StringVar(&ownerName, "owner", "", "rank the authors of every repo of this owner")
+			fs.Int64Var(&filter.Limit, "limit", 10, "number of authors")
+			positional, err := e.parse(fs, args, 0, 1)
+			if err != nil {
+				return err
+			}
+
+			switch len(positional) {
+			case 0:
+				filter.OwnerName = ownerName
+			case 1:
+				if filter.OwnerName, filter.RepoName, err = parseRepo(positional[0]); err != nil {
+					return err
+				}
+			}
+
+			list, err := e.client.ListTopAuthors(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			rows := make([][]string, 0, len(list))
+			for i, author := range list {
+				rank := author.Rank
+				if rank == 0 {
+					rank = i + 1
+				}
+				rows = append(rows, []string{
+					strconv.Itoa(rank),
+					author.Author,
+					author.Email,
+					strconv.Itoa(author.CommitsCount),
+				})
+			}
+
+			return e.render(list, []string{"RANK", "AUTHOR", "EMAIL", "COMMITS"}, rows)
+		},
+	},
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gitbeam/models"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+var commitsCommands = map[string]command{
+	"list": {
+		usage:   "OWNER/REPO [--branch B] [--path P] [--from DATE] [--to DATE]",
+		summary: "list the mirrored commits of a repo",
+		run: func(ctx context.Context, e *env, args []string) error {
+			var filter models.CommitFilters
+			fs := e.flags("commits list")
+			commitFilterFlags(fs, &filter)
+			fs.Int64Var(&filter.Page, "page", 0, "page of results")
+			fs.Int64Var(&filter.Limit, "limit", 0, "results per page")
+			positional, err := e.parse(fs, args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			if filter.OwnerName, filter.RepoName, err = parseRepo(positional[0]); err != nil {
+				return err
+			}
+
+			list, err := e.client.ListCommits(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			rows := make([][]string, 0, len(list))
+			for _, commit := range list {
+				rows = append(rows, commitRow(commit))
+			}
+
+			return e.render(list, commitHeaders, rows)
+		},
+	},
+	"get": {
+		usage:   "OWNER/REPO SHA",
+		summary: "show a commit",
+		run: func(ctx context.Context, e *env, args []string) error {
+			positional, err := e.parse(e.flags("commits get"), args, 2, 2)
+			if err != nil {
+				return err
+			}
+
+			ownerName, repoName, err := parseRepo(positional[0])
+			if err != nil {
+				return err
+			}
+
+			commit, err := e.client.GetCommit(ctx, ownerName, repoName, positional[1])
+			if err != nil {
+				return err
+			}
+
+			return e.render(commit, commitHeaders, [][]string{commitRow(commit)})
+		},
+	},
+	"search": {
+		usage:   "QUERY [--repo OWNER/REPO] [--author A]",
+		summary: "search the messages of the mirrored commits",
+		run: func(ctx context.Context, e *env, args []string) error {
+			var filter models.CommitSearchFilters
+			var repo string
+			fs := e.flags("commits search")
+			commitFilterFlags(fs, &filter.CommitFilters)
+			fs.StringVar(&repo, "repo", "", "only the commits of this owner/repo")
+			fs.StringVar(&filter.Author, "author", "", "only the commits of this author")
+			fs.Int64Var(&filter.Page, "page", 0, "page of results")
+			fs.Int64Var(&filter.Limit, "limit", 0, "results per page")
+			positional, err := e.parse(fs, args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			filter.Query = positional[0]
+			if repo != "" {
+				if filter.OwnerName, filter.RepoName, err = parseRepo(repo); err != nil {
+					return err
+				}
+			}
+
+			list, err := e.client.SearchCommits(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			rows := make([][]string, 0, len(list))
+			for _, result := range list {
+				rows = append(rows, append(commitRow(result.Commit), truncate(result.Snippet, 60)))
+			}
+
+			return e.render(list, append(commitHeaders, "SNIPPET"), rows)
+		},
+	},
+	"export": {
+		usage:   "OWNER/REPO [--format csv|ndjson|parquet] [--out FILE]",
+		summary: "export the mirrored commits of a repo",
+		run: func(ctx context.Context, e *env, args []string) error {
+			var filter models.CommitExportFilters
+			var out string
+			fs := e.flags("commits export")
+			commitFilterFlags(fs, &filter.CommitFilters)
+			fs.StringVar(&filter.Format, "format", "csv", "csv, ndjson or parquet")
+			fs.StringVar(&out, "out", "", "file to write the export to, defaults to stdout")
+			positional, err := e.parse(fs, args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			if filter.OwnerName, filter.RepoName, err = parseRepo(positional[0]); err != nil {
+				return err
+			}
+
+			export, err := e.client.ExportCommits(ctx, filter)
+			if err != nil {
+				return err
+			}
+			defer export.Close()
+
+			w := e.stdout
+			if out != "" {
+				file, err := os.Create(out)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+
+			_, err = io.Copy(w, export)
+			return err
+		},
+	},
+}
+
+var commitHeaders = []string{"SHA", "DATE", "AUTHOR", "MESSAGE"}
+
+func commitRow(commit models.Commit) []string {
+	return []string{
+		truncate(commit.SHA, 10),
+		commit.Date.Format(time.DateOnly),
+		commit.Author,
+		truncate(commit.Message, 72),
+	}
+}
+
+// commitFilterFlags registers the flags of the commit filters shared by the commands listing commits.
+func commitFilterFlags(fs *flag.FlagSet, filter *models.CommitFilters) {
+	fs.StringVar(&filter.Branch, "branch", "", "only the commits on this branch")
+	fs.StringVar(&filter.Path, "path", "", "only the commits touching this path")
+	fs.Var(dateFlag{&filter.FromDate}, "from", "only the commits from this date, YYYY-MM-DD")
+	fs.Var(dateFlag{&filter.ToDate}, "to", "only the commits until this date, YYYY-MM-DD")
+	fs.BoolVar(&filter.IncludeBots, "include-bots", false, "include the commits of bots")
+	fs.Func("trailer", "only the commits with this trailer, KEY=VALUE, repeatable", func(value string) error {
+		key, trailerValue, ok := strings.Cut(value, "=")
+		if !ok {
+			return fmt.Errorf("%q isn't a KEY=VALUE trailer", value)
+		}
+
+		if filter.Trailers == nil {
+			filter.Trailers = make(map[string]string)
+		}
+		filter.Trailers[key] = trailerValue
+		return nil
+	})
+}
+
+func paginationFlags(fs *flag.FlagSet, page *models.Pagination) {
+	fs.Int64Var(&page.Page, "page", 0, "page of results")
+	fs.Int64Var(&page.Limit, "limit", 0, "results per page")
+}
+
+// dateFlag parses YYYY-MM-DD dates into the *models.Date of a filter.
+type dateFlag struct {
+	date **models.Date
+}
+
+func (d dateFlag) String() string {
+	if d.date == nil || *d.date == nil {
+		return ""
+	}
+
+	return (*d.date).Format(time.DateOnly)
+}
+
+func (d dateFlag) Set(value string) error {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return fmt.Errorf("%q isn't a YYYY-MM-DD date", value)
+	}
+
+	*d.date = &models.Date{Time: t}
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+var globalFlags = "-o --profile --url --config"
+
+// completionScripts complete the command groups, their commands and the global flags.
+var completionScripts = map[string]string{
+	"bash": bashCompletion,
+	"zsh":  "#compdef gitbeam\nautoload -U +X bashcompinit && bashcompinit\n" + bashCompletion,
+	"fish": fishCompletion,
+}
+
+const bashCompletion = `_gitbeam() {
+  local cur=${COMP_WORDS[COMP_CWORD]}
+  if [[ $cur == -* ]]; then
+    COMPREPLY=($(compgen -W "{{.Flags}}" -- "$cur"))
+    return
+  fi
+
+  case $COMP_CWORD in
+    1) COMPREPLY=($(compgen -W "{{.Groups}} completion help" -- "$cur")) ;;
+    2)
+      case ${COMP_WORDS[1]} in
+{{- range .Commands}}
+        {{.Group}}) COMPREPLY=($(compgen -W "{{.Names}}" -- "$cur")) ;;
+{{- end}}
+        completion) COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur")) ;;
+      esac
+      ;;
+  esac
+}
+complete -F _gitbeam gitbeam
+`
+
+const fishCompletion = `complete -c gitbeam -f
+complete -c gitbeam -n __fish_use_subcommand -a "{{.Groups}} completion help"
+{{- range .Commands}}
+complete -c gitbeam -n "__fish_seen_subcommand_from {{.Group}}; and not __fish_seen_subcommand_from {{.Names}}" -a "{{.Names}}"
+{{- end}}
+complete -c gitbeam -n "__fish_seen_subcommand_from completion" -a "bash zsh fish"
+complete -c gitbeam -s o -x -a "table json yaml" -d "output format"
+complete -c gitbeam -l profile -x -d "config profile of the gateway to call"
+complete -c gitbeam -l url -x -d "URL of the gateway to call"
+complete -c gitbeam -l config -r -d "path of the config file"
+`
+
+func writeCompletion(w io.Writer, shell string) error {
+	script, ok := completionScripts[shell]
+	if !ok {
+		return fmt.Errorf("no completion for %q, use one of bash, zsh or fish", shell)
+	}
+
+	type groupCommands struct {
+		Group string
+		Names string
+	}
+
+	data := struct {
+		Flags    string
+		Groups   string
+		Commands []groupCommands
+	}{Flags: globalFlags, Groups: strings.Join(groups, " ")}
+	for _, group := range groups {
+		data.Commands = append(data.Commands, groupCommands{Group: group, Names: strings.Join(names(commands[group]), " ")})
+	}
+
+	return template.Must(template.New(shell).Parse(script)).Execute(w, data)
+}
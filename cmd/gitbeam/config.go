@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+)
+
+const defaultGatewayURL = "http://localhost:8080"
+
+// config holds the gateways the CLI can call, each under a profile name.
+type config struct {
+	Current  string             `yaml:"current,omitempty" json:"current"`
+	Profiles map[string]profile `yaml:"profiles,omitempty" json:"profiles"`
+}
+
+type profile struct {
+	URL   string `yaml:"url" json:"url"`
+	Token string `yaml:"token,omitempty" json:"-"`
+}
+
+// configPath returns the config file to use, $XDG_CONFIG_HOME/gitbeam/config.yaml unless path is set.
+func configPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "gitbeam", "config.yaml"), nil
+}
+
+// loadConfig reads the config file, a missing one being an empty config.
+func loadConfig(path string) (config, error) {
+	var cfg config
+	path, err := configPath(path)
+	if err != nil {
+		return cfg, err
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+
+	if err != nil {
+		return cfg, err
+	}
+
+	if err = yaml.Unmarshal(content, &cfg); err != nil {
+		return cfg, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+func (c config) save(path string) error {
+	path, err := configPath(path)
+	if err != nil {
+		return err
+	}
+
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o600)
+}
+
+// gateway resolves the gateway to call: an explicit URL, else the one of the given profile, else the one
+// of the current profile, else a gateway running locally. A token set in GITBEAM_TOKEN overrides the
+// one of the profile.
+func (c config) gateway(profileName, url string) (profile, error) {
+	p, err := c.profile(profileName, url)
+	if token := os.Getenv("GITBEAM_TOKEN"); token != "" {
+		p.Token = token
+	}
+
+	return p, err
+}
+
+func (c config) profile(profileName, url string) (profile, error) {
+	if url != "" {
+		return profile{URL: url}, nil
+	}
+
+	if profileName == "" {
+		profileName = c.Current
+	}
+
+	if profileName == "" {
+		return profile{URL: defaultGatewayURL}, nil
+	}
+
+	p, ok := c.Profiles[profileName]
+	if !ok {
+		return p, fmt.Errorf("unknown profile %q, add it with gitbeam config set %s --url URL", profileName, profileName)
+	}
+
+	return p, nil
+}
+
+var configCommands = map[string]command{
+	"list": {
+		summary: "list the profiles of the config file",
+		run: func(_ context.Context, e *env, args []string) error {
+			fs := e.flags("config list")
+			if _, err := e.parseArgs(fs, args, 0, 0); err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(e.config)
+			if err != nil {
+				return err
+			}
+
+			var rows [][]string
+			for _, name := range sortedKeys(cfg.Profiles) {
+				current := ""
+				if name == cfg.Current {
+					current = "*"
+				}
+				rows = append(rows, []string{current, name, cfg.Profiles[name].URL})
+			}
+
+			return e.render(cfg, []string{"CURRENT", "NAME", "URL"}, rows)
+		},
+	},
+	"set": {
+		usage:   "NAME --url URL [--token TOKEN]",
+		summary: "add or update a profile",
+		run: func(_ context.Context, e *env, args []string) error {
+			var token string
+			fs := e.flags("config set")
+			fs.StringVar(&token, "token", "", "bearer token to call the gateway with")
+			positional, err := e.parseArgs(fs, args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			if e.url == "" {
+				return errors.New("--url is required")
+			}
+
+			cfg, err := loadConfig(e.config)
+			if err != nil {
+				return err
+			}
+
+			if cfg.Profiles == nil {
+				cfg.Profiles = make(map[string]profile)
+			}
+			cfg.Profiles[positional[0]] = profile{URL: e.url, Token: token}
+			if cfg.Current == "" {
+				cfg.Current = positional[0]
+			}
+
+			return cfg.save(e.config)
+		},
+	},
+	"use": {
+		usage:   "NAME",
+		summary: "make a profile the current one",
+		run: func(_ context.Context, e *env, args []string) error {
+			fs := e.flags("config use")
+			positional, err := e.parseArgs(fs, args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(e.config)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := cfg.Profiles[positional[0]]; !ok {
+				return fmt.Errorf("unknown profile %q", positional[0])
+			}
+			cfg.Current = positional[0]
+
+			return cfg.save(e.config)
+		},
+	},
+}
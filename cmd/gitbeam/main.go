@@ -0,0 +1,206 @@
+// Command gitbeam is a command-line client of the gitbeam gateway.
+//
+//	gitbeam repos list --language Go --sort stars
+//	gitbeam commits list chromium/chromium --branch main --limit 20 -o json
+//	gitbeam monitor start chromium/chromium --every 1 --branch main
+//
+// Run gitbeam help for every command.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"gitbeam/client"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+)
+
+// command is a leaf of the command tree, e.g. the list of repos list.
+type command struct {
+	usage   string
+	summary string
+	run     func(ctx context.Context, e *env, args []string) error
+}
+
+// commands are grouped by the resource they act on, in the order they are listed by help.
+var groups = []string{"repos", "commits", "monitor", "authors", "config"}
+
+var commands = map[string]map[string]command{
+	"repos":   reposCommands,
+	"commits": commitsCommands,
+	"monitor": monitorCommands,
+	"authors": authorsCommands,
+	"config":  configCommands,
+}
+
+// env is what every command runs with, the global flags and the client of the selected gateway.
+type env struct {
+	stdout  io.Writer
+	output  string
+	profile string
+	url     string
+	config  string
+	client  *client.Client
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "gitbeam:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stdout)
+		return nil
+	}
+
+	if args[0] == "completion" {
+		if len(args) != 2 {
+			return errors.New("usage: gitbeam completion bash|zsh|fish")
+		}
+
+		return writeCompletion(stdout, args[1])
+	}
+
+	group, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q, run gitbeam help", args[0])
+	}
+
+	if len(args) < 2 {
+		return fmt.Errorf("missing %s command, one of %s", args[0], strings.Join(names(group), ", "))
+	}
+
+	cmd, ok := group[args[1]]
+	if !ok {
+		return fmt.Errorf("unknown command %q, run gitbeam help", args[0]+" "+args[1])
+	}
+
+	err := cmd.run(ctx, &env{stdout: stdout}, args[2:])
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	return err
+}
+
+// flags returns the flag set of a command, with the global flags registered on it.
+func (e *env) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("gitbeam "+name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&e.output, "o", outputTable, "output format: table, json or yaml")
+	fs.StringVar(&e.profile, "profile", os.Getenv("GITBEAM_PROFILE"), "config profile of the gateway to call")
+	fs.StringVar(&e.url, "url", os.Getenv("GITBEAM_URL"), "URL of the gateway to call, overrides the profile")
+	fs.StringVar(&e.config, "config", os.Getenv("GITBEAM_CONFIG"), "path of the config file")
+	return fs
+}
+
+// parse parses the flags of a command and connects to the gateway of the selected profile.
+// It returns the positional arguments, of which there must be between minArgs and maxArgs.
+func (e *env) parse(fs *flag.FlagSet, args []string, minArgs, maxArgs int) ([]string, error) {
+	positional, err := e.parseArgs(fs, args, minArgs, maxArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := loadConfig(e.config)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := cfg.gateway(e.profile, e.url)
+	if err != nil {
+		return nil, err
+	}
+
+	e.client = client.New(gateway.URL, client.WithToken(gateway.Token))
+	return positional, nil
+}
+
+// parseArgs parses the flags of a command, which may come before, after or between its positional arguments.
+func (e *env) parseArgs(fs *flag.FlagSet, args []string, minArgs, maxArgs int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintf(e.stdout, "Usage of %s:\n", fs.Name())
+				fs.SetOutput(e.stdout)
+				fs.PrintDefaults()
+			}
+
+			return nil, err
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) < minArgs || len(positional) > maxArgs {
+		return nil, fmt.Errorf("wrong number of arguments to %s, run gitbeam help", fs.Name())
+	}
+
+	switch e.output {
+	case outputTable, outputJSON, outputYAML:
+	default:
+		return nil, fmt.Errorf("-o must be one of %s, %s or %s", outputTable, outputJSON, outputYAML)
+	}
+
+	return positional, nil
+}
+
+// parseRepo splits an owner/repo argument.
+func parseRepo(arg string) (ownerName, repoName string, err error) {
+	ownerName, repoName, ok := strings.Cut(arg, "/")
+	if !ok || ownerName == "" || repoName == "" || strings.Contains(repoName, "/") {
+		return "", "", fmt.Errorf("%q isn't an owner/repo", arg)
+	}
+
+	return ownerName, repoName, nil
+}
+
+func names(group map[string]command) []string {
+	var list []string
+	for name := range group {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "gitbeam is a command-line client of the gitbeam gateway.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Usage:")
+	for _, groupName := range groups {
+		group := commands[groupName]
+		for _, name := range names(group) {
+			fmt.Fprintf(w, "  gitbeam %s %s %s\n      %s\n", groupName, name, group[name].usage, group[name].summary)
+		}
+	}
+	fmt.Fprintf(w, "  gitbeam completion bash|zsh|fish\n      %s\n", "print the shell completion script")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Every command takes:")
+	fmt.Fprintln(w, "  -o table|json|yaml   output format, defaults to table")
+	fmt.Fprintln(w, "  --profile NAME       config profile of the gateway to call ( GITBEAM_PROFILE )")
+	fmt.Fprintln(w, "  --url URL            URL of the gateway to call, overrides the profile ( GITBEAM_URL )")
+	fmt.Fprintln(w, "  --config PATH        path of the config file ( GITBEAM_CONFIG )")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "GITBEAM_TOKEN overrides the bearer token of the profile.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run gitbeam GROUP COMMAND -h for the flags of a command.")
+}
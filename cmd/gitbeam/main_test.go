@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"gitbeam/api"
+	"gitbeam/api/pb/commits"
+	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/manifest"
+	"gitbeam/mocks"
+	"gitbeam/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newGateway(t *testing.T, commitsRPC commits.GitBeamCommitsServiceClient, reposRPC gitRepos.GitBeamRepositoryServiceClient) *httptest.Server {
+	router := chi.NewMux()
+	api.New(commitsRPC, reposRPC, logrus.New()).Routes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestReposCommands(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockRepoRPC.EXPECT().ListGitRepositories(gomock.Any(), gomock.Any()).Times(3).Return(
+		&gitRepos.ListGitRepositoriesResponse{
+			Repos: []*gitRepos.Repo{
+				{Owner: "chromium", Name: "chromium", Language: "C++", StarCounts: 19000, Monitored: true},
+			},
+		}, nil)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{
+		OwnerName: "brave",
+		RepoName:  "brave-browser",
+	}).Return(&gitRepos.Repo{Owner: "brave", Name: "brave-browser", Language: "TypeScript"}, nil)
+
+	gateway := newGateway(t, nil, mockRepoRPC)
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	ctx := context.Background()
+
+	var stdout bytes.Buffer
+	assert.Nil(t, run(ctx, []string{"repos", "list", "--config", configFile, "--url", gateway.URL, "--language", "C++"}, &stdout))
+	assert.Contains(t, stdout.String(), "REPO")
+	assert.Contains(t, stdout.String(), "chromium/chromium  C++")
+
+	stdout.Reset()
+	assert.Nil(t, run(ctx, []string{"repos", "list", "--config", configFile, "--url", gateway.URL, "-o", "json"}, &stdout))
+	var repos []models.Repo
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &repos))
+	assert.Equal(t, "chromium", repos[0].Name)
+
+	stdout.Reset()
+	assert.Nil(t, run(ctx, []string{"repos", "list", "--config", configFile, "--url", gateway.URL, "-o", "yaml"}, &stdout))
+	assert.Contains(t, stdout.String(), "  owner: chromium\n")
+
+	// Flags may come after the positional arguments.
+	stdout.Reset()
+	assert.Nil(t, run(ctx, []string{"repos", "get", "brave/brave-browser", "--config", configFile, "--url", gateway.URL}, &stdout))
+	assert.Contains(t, stdout.String(), "brave/brave-browser")
+
+	assert.ErrorContains(t, run(ctx, []string{"repos", "get", "brave", "--config", configFile, "--url", gateway.URL}, &stdout), "isn't an owner/repo")
+	assert.ErrorContains(t, run(ctx, []string{"repos", "list", "--config", configFile, "-o", "xml"}, &stdout), "-o must be one of")
+	assert.ErrorContains(t, run(ctx, []string{"repos", "delete"}, &stdout), "unknown command")
+}
+
+func TestAuthorsCommands(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockCommitsRPC.EXPECT().ListTopCommitAuthor(gomock.Any(), &commits.CommitFilterParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Limit:     10,
+	}).Times(2).Return(&commits.ListTopCommitAuthorResponse{Data: []*commits.TopCommitAuthor{
+		{Author: "Marc Treib", Email: "treib@chromium.org", CommitsCount: 42, Rank: 1},
+	}}, nil)
+
+	gateway := newGateway(t, mockCommitsRPC, nil)
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	ctx := context.Background()
+
+	var stdout bytes.Buffer
+	assert.Nil(t, run(ctx, []string{"authors", "top", "chromium/chromium", "--config", configFile, "--url", gateway.URL}, &stdout))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Equal(t, []string{"RANK", "AUTHOR", "EMAIL", "COMMITS"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"1", "Marc", "Treib", "treib@chromium.org", "42"}, strings.Fields(lines[1]))
+
+	stdout.Reset()
+	assert.Nil(t, run(ctx, []string{"authors", "top", "chromium/chromium", "--config", configFile, "--url", gateway.URL, "-o", "json"}, &stdout))
+	var authors []models.TopCommitAuthor
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &authors))
+	assert.Equal(t, 42, authors[0].CommitsCount)
+	assert.Contains(t, stdout.String(), `"commitsCount": 42`)
+}
+
+func TestMonitorStatusCommand(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockCommitsRPC.EXPECT().ListMonitoredRepositories(gomock.Any(), &commits.Void{}).Times(3).Return(&commits.ListMonitoredRepositoriesResponse{
+		Data: []*commits.MonitorRepositoryCommitsConfigParams{
+			{OwnerName: "chromium", RepoName: "chromium", DurationInHours: 1},
+			{OwnerName: "mozilla", RepoName: "gecko-dev", DurationInHours: 1},
+		},
+	}, nil)
+
+	path := filepath.Join(t.TempDir(), "gitbeam.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("repos:\n  - ownerName: chromium\n    repoName: chromium\n    durationInHours: 1\n"), 0o644))
+	reconciler := manifest.NewReconciler(path, time.Minute, manifest.NewApplier(mockCommitsRPC, nil), logrus.New())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reconciler.Run(ctx)
+
+	router := chi.NewMux()
+	api.New(mockCommitsRPC, nil, logrus.New()).WithReconciler(reconciler).Routes(router)
+	gateway := httptest.NewServer(router)
+	defer gateway.Close()
+
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	var stdout bytes.Buffer
+	assert.Nil(t, run(context.Background(), []string{"monitor", "status", "--config", configFile, "--url", gateway.URL}, &stdout))
+	assert.Contains(t, stdout.String(), "in sync: true")
+	assert.Contains(t, stdout.String(), "mozilla/gecko-dev")
+	assert.Contains(t, stdout.String(), "unlisted")
+
+	// drift is an alias of status.
+	stdout.Reset()
+	assert.Nil(t, run(context.Background(), []string{"monitor", "drift", "--config", configFile, "--url", gateway.URL}, &stdout))
+	assert.Contains(t, stdout.String(), "unlisted")
+
+	// Without a monitoring config the gateway has no status to show.
+	assert.ErrorIs(t, run(context.Background(), []string{"monitor", "status", "--config", configFile, "--url", newGateway(t, nil, nil).URL}, &stdout), errNoMonitoringConfig)
+}
+
+func TestConfigCommands(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), gomock.Any()).Return(&gitRepos.Repo{Owner: "brave", Name: "brave-browser"}, nil)
+
+	gateway := newGateway(t, nil, mockRepoRPC)
+	configFile := filepath.Join(t.TempDir(), "gitbeam", "config.yaml")
+	ctx := context.Background()
+
+	var stdout bytes.Buffer
+	assert.Nil(t, run(ctx, []string{"config", "set", "staging", "--url", "http://staging.invalid", "--config", configFile}, &stdout))
+	assert.Nil(t, run(ctx, []string{"config", "set", "local", "--url", gateway.URL, "--config", configFile}, &stdout))
+	assert.ErrorContains(t, run(ctx, []string{"config", "use", "prod", "--config", configFile}, &stdout), `unknown profile "prod"`)
+
+	// The first profile set is the current one until another is used.
+	assert.Nil(t, run(ctx, []string{"config", "list", "--config", configFile}, &stdout))
+	assert.Contains(t, stdout.String(), "*        staging")
+
+	assert.Nil(t, run(ctx, []string{"config", "use", "local", "--config", configFile}, &stdout))
+	stdout.Reset()
+	assert.Nil(t, run(ctx, []string{"repos", "get", "brave/brave-browser", "--config", configFile}, &stdout))
+	assert.Contains(t, stdout.String(), "brave/brave-browser")
+
+	assert.ErrorContains(t, run(ctx, []string{"repos", "get", "brave/brave-browser", "--config", configFile, "--profile", "prod"}, &stdout), `unknown profile "prod"`)
+}
+
+func TestCompletion(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		var stdout bytes.Buffer
+		assert.Nil(t, run(context.Background(), []string{"completion", shell}, &stdout))
+		assert.Contains(t, stdout.String(), "monitor")
+		assert.Contains(t, stdout.String(), "start")
+	}
+
+	assert.ErrorContains(t, run(context.Background(), []string{"completion", "powershell"}, &bytes.Buffer{}), "powershell")
+}
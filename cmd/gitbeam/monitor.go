@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gitbeam/client"
+	"gitbeam/models"
+	"strconv"
+)
+
+var errNoMonitoringConfig = errors.New("the gateway has no monitoring config, set MONITORING_CONFIG_PATH to reconcile from one, or use monitor list")
+
+var monitorCommands = map[string]command{
+	"start": {
+		usage:   "OWNER/REPO [--every HOURS] [--from DATE] [--to DATE] [--branch B]",
+		summary: "start mirroring the commits of a repo",
+		run: func(ctx context.Context, e *env, args []string) error {
+			var config models.MirrorRepoCommitsRequest
+			fs := e.flags("monitor start")
+			every := fs.Int64("every", 1, "hours between two pulls of new commits")
+			fs.Var(dateFlag{&config.FromDate}, "from", "mirror the commits from this date, YYYY-MM-DD")
+			fs.Var(dateFlag{&config.ToDate}, "to", "mirror the commits until this date, YYYY-MM-DD")
+			fs.Func("branch", "branch name or glob to mirror, repeatable", func(value string) error {
+				config.Branches = append(config.Branches, value)
+				return nil
+			})
+			fs.Func("webhook", "URL to POST new commits to, repeatable", func(value string) error {
+				config.Webhooks = append(config.Webhooks, value)
+				return nil
+			})
+			fs.BoolVar(&config.EnrichDiffStats, "enrich-diff-stats", false, "store the diff stats and files of every commit")
+			positional, err := e.parse(fs, args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			if config.OwnerName, config.RepoName, err = parseRepo(positional[0]); err != nil {
+				return err
+			}
+
+			config.DurationInHours = every
+			if err = e.client.StartMonitoring(ctx, config); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(e.stdout, "started monitoring %s\n", positional[0])
+			return err
+		},
+	},
+	"stop": {
+		usage:   "OWNER/REPO",
+		summary: "stop mirroring the commits of a repo",
+		run: func(ctx context.Context, e *env, args []string) error {
+			positional, err := e.parse(e.flags("monitor stop"), args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			var repo models.OwnerAndRepoName
+			if repo.OwnerName, repo.RepoName, err = parseRepo(positional[0]); err != nil {
+				return err
+			}
+
+			if err = e.client.StopMonitoring(ctx, repo); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(e.stdout, "stopped monitoring %s\n", positional[0])
+			return err
+		},
+	},
+	"list": {
+		summary: "list the repos whose commits are mirrored",
+		run: func(ctx context.Context, e *env, args []string) error {
+			filter := models.RepoFilters{SortBy: models.RepoSortByName}
+			fs := e.flags("monitor list")
+			paginationFlags(fs, &filter.Pagination)
+			if _, err := e.parse(fs, args, 0, 0); err != nil {
+				return err
+			}
+
+			monitored := true
+			filter.Monitored = &monitored
+			repos, err := e.client.ListRepos(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			return e.renderRepos(repos)
+		},
+	},
+	"status": monitorStatus,
+	"drift": {
+		summary: "alias of monitor status",
+		run:     monitorStatus.run,
+	},
+}
+
+// monitorStatus shows the last reconciliation of the monitoring config, and how the mirrored repos drift from it.
+var monitorStatus = command{
+	summary: "show the last reconciliation of the monitoring config file of the gateway, and the drift from it",
+	run: func(ctx context.Context, e *env, args []string) error {
+		if _, err := e.parse(e.flags("monitor status"), args, 0, 0); err != nil {
+			return err
+		}
+
+		status, err := e.client.GetReconcileStatus(ctx)
+		if errors.Is(err, client.ErrNotFound) {
+			return errNoMonitoringConfig
+		}
+
+		if err != nil {
+			return err
+		}
+
+		var rows [][]string
+		if status.Drift != nil {
+			for _, repo := range status.Drift.Missing {
+				rows = append(rows, []string{repo.OwnerName + "/" + repo.RepoName, "missing"})
+			}
+			for _, repo := range status.Drift.Changed {
+				rows = append(rows, []string{repo.OwnerName + "/" + repo.RepoName, "changed"})
+			}
+			for _, repo := range status.Drift.Unlisted {
+				rows = append(rows, []string{repo.OwnerName + "/" + repo.RepoName, "unlisted"})
+			}
+			for _, repo := range status.Drift.Released {
+				rows = append(rows, []string{repo.OwnerName + "/" + repo.RepoName, "released"})
+			}
+		}
+
+		if e.output == outputTable {
+			fmt.Fprintf(e.stdout, "config: %s\nin sync: %s\n", status.Path, strconv.FormatBool(status.InSync))
+			if status.LastError != "" {
+				fmt.Fprintf(e.stdout, "last error: %s\n", status.LastError)
+			}
+			if len(rows) == 0 {
+				return nil
+			}
+			fmt.Fprintln(e.stdout)
+		}
+
+		return e.render(status, []string{"REPO", "DRIFT"}, rows)
+	},
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"gitbeam/models"
+	"strconv"
+)
+
+var reposCommands = map[string]command{
+	"list": {
+		usage:   "[--owner O] [--language L] [--name N] [--min-stars N] [--sort stars|updated|name] [--order asc|desc]",
+		summary: "list the repos of the repo manager",
+		run: func(ctx context.Context, e *env, args []string) error {
+			var filter models.RepoFilters
+			fs := e.flags("repos list")
+			fs.StringVar(&filter.OwnerName, "owner", "", "only the repos of this owner")
+			fs.StringVar(&filter.Language, "language", "", "only the repos in this language")
+			fs.StringVar(&filter.Name, "name", "", "only the repos whose name contains this")
+			fs.Int64Var(&filter.MinStars, "min-stars", 0, "only the repos with at least this many stars")
+			fs.StringVar(&filter.SortBy, "sort", "", "sort by stars, updated or name")
+			fs.StringVar(&filter.Order, "order", "", "asc or desc")
+			paginationFlags(fs, &filter.Pagination)
+			if _, err := e.parse(fs, args, 0, 0); err != nil {
+				return err
+			}
+
+			repos, err := e.client.ListRepos(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			return e.renderRepos(repos)
+		},
+	},
+	"get": {
+		usage:   "OWNER/REPO",
+		summary: "show a repo",
+		run: func(ctx context.Context, e *env, args []string) error {
+			positional, err := e.parse(e.flags("repos get"), args, 1, 1)
+			if err != nil {
+				return err
+			}
+
+			ownerName, repoName, err := parseRepo(positional[0])
+			if err != nil {
+				return err
+			}
+
+			repo, err := e.client.GetRepo(ctx, ownerName, repoName)
+			if err != nil {
+				return err
+			}
+
+			return e.render(repo, repoHeaders, [][]string{repoRow(repo)})
+		},
+	},
+}
+
+var repoHeaders = []string{"REPO", "LANGUAGE", "STARS", "FORKS", "OPEN ISSUES", "MONITORED", "STATUS"}
+
+func repoRow(repo models.Repo) []string {
+	return []string{
+		repo.Owner + "/" + repo.Name,
+		repo.Language,
+		strconv.FormatInt(repo.StarCount, 10),
+		strconv.FormatInt(repo.ForkCount, 10),
+		strconv.FormatInt(repo.OpenIssues, 10),
+		strconv.FormatBool(repo.Monitored),
+		repo.Status,
+	}
+}
+
+func (e *env) renderRepos(repos []models.Repo) error {
+	rows := make([][]string, 0, len(repos))
+	for _, repo := range repos {
+		rows = append(rows, repoRow(repo))
+	}
+
+	return e.render(repos, repoHeaders, rows)
+}
@@ -6,6 +6,7 @@ import (
 	"go/build"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 const ServiceName = "gitbeam"
@@ -14,6 +15,9 @@ type Secrets struct {
 	CommitsMonitorURL string `json:"COMMITS_MONITOR_URL"`
 	RepoManagerURL    string `json:"REPO_MANAGER_URL"`
 	Port              string
+	// MonitoringConfigPath is a manifest file or directory the monitored repos are reconciled with, if set.
+	MonitoringConfigPath         string        `json:"MONITORING_CONFIG_PATH"`
+	MonitoringConfigPollInterval time.Duration `json:"MONITORING_CONFIG_POLL_INTERVAL"`
 }
 
 var ss Secrets
@@ -32,6 +36,13 @@ func init() {
 	if ss.Port = os.Getenv("PORT"); ss.Port == "" {
 		ss.Port = "80"
 	}
+	ss.MonitoringConfigPath = os.Getenv("MONITORING_CONFIG_PATH")
+	if interval := os.Getenv("MONITORING_CONFIG_POLL_INTERVAL"); interval != "" {
+		// Fail rather than polling at an interval nobody asked for.
+		if ss.MonitoringConfigPollInterval, err = time.ParseDuration(interval); err != nil || ss.MonitoringConfigPollInterval <= 0 {
+			panic(fmt.Sprintf("MONITORING_CONFIG_POLL_INTERVAL must be a positive duration, e.g. 30s, got %q", interval))
+		}
+	}
 }
 
 // GetSecrets is used to get value from the Secrets runtime.
@@ -0,0 +1,45 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"gitbeam/api/pb/commits"
+	"io"
+)
+
+type csvWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{writer: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) Write(commit *commits.Commit) error {
+	if !c.headerWritten {
+		if err := c.writer.Write(columns); err != nil {
+			return err
+		}
+		c.headerWritten = true
+	}
+
+	values := row(commit)
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = fmt.Sprint(value)
+	}
+
+	return c.writer.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	if !c.headerWritten {
+		if err := c.writer.Write(columns); err != nil {
+			return err
+		}
+	}
+
+	c.writer.Flush()
+	return c.writer.Error()
+}
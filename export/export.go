@@ -0,0 +1,87 @@
+package export
+
+import (
+	"errors"
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	"io"
+	"strings"
+)
+
+const (
+	FormatCSV     = models.ExportFormatCSV
+	FormatNDJSON  = models.ExportFormatNDJSON
+	FormatParquet = models.ExportFormatParquet
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported export format, use one of csv, ndjson or parquet")
+
+// Writer encodes commits one at a time, Close must be called to flush what is left buffered.
+type Writer interface {
+	Write(commit *commits.Commit) error
+	Close() error
+}
+
+// NewWriter returns the Writer of the given format writing into w.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	case FormatParquet:
+		return newParquetWriter(w)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the media type of the given format.
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// columns are the fields of a commit in the order every format lays them out.
+var columns = []string{
+	"sha",
+	"ownerName",
+	"repoName",
+	"date",
+	"author",
+	"authorEmail",
+	"authorLogin",
+	"message",
+	"url",
+	"parentCommitIDs",
+	"branches",
+	"additions",
+	"deletions",
+}
+
+// row flattens a commit into its columns, lists are joined by commas.
+func row(commit *commits.Commit) []any {
+	return []any{
+		commit.GetSha(),
+		commit.GetOwnerName(),
+		commit.GetRepoName(),
+		commit.GetDate(),
+		commit.GetAuthor(),
+		commit.GetAuthorEmail(),
+		commit.GetAuthorLogin(),
+		commit.GetMessage(),
+		commit.GetUrl(),
+		strings.Join(commit.GetParentCommitIDs(), ","),
+		strings.Join(commit.GetBranches(), ","),
+		commit.GetStats().GetAdditions(),
+		commit.GetStats().GetDeletions(),
+	}
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"gitbeam/api/pb/commits"
+	"github.com/stretchr/testify/assert"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"testing"
+)
+
+var testCommits = []*commits.Commit{
+	{
+		Sha:             "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+		OwnerName:       "chromium",
+		RepoName:        "chromium",
+		Author:          "Marc Treib",
+		Message:         "HistoryBackend: Drop HistoryDBTasks in Closing()\n\nBug: 354474887",
+		ParentCommitIDs: []string{"a70fc91846eaa0da2db1de18b8f344b485eb7996"},
+		Stats:           &commits.CommitStats{Additions: 12, Deletions: 3, Total: 15},
+	},
+	{
+		Sha:       "d5dffa29e74bebe402cbf23c2b1c2ecf33e84971",
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+		Author:    "Sophie Chang",
+	},
+}
+
+func writeAll(t *testing.T, format string) *bytes.Buffer {
+	buffer := new(bytes.Buffer)
+	writer, err := NewWriter(format, buffer)
+	assert.Nil(t, err)
+	for _, commit := range testCommits {
+		assert.Nil(t, writer.Write(commit))
+	}
+	assert.Nil(t, writer.Close())
+	return buffer
+}
+
+func TestCSVWriter(t *testing.T) {
+	records, err := csv.NewReader(writeAll(t, FormatCSV)).ReadAll()
+	assert.Nil(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, columns, records[0])
+	assert.Equal(t, "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01", records[1][0])
+	assert.Equal(t, testCommits[0].Message, records[1][7])
+	assert.Equal(t, "12", records[1][11])
+	assert.Equal(t, "0", records[2][11])
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	decoder := json.NewDecoder(writeAll(t, FormatNDJSON))
+	for _, expected := range testCommits {
+		var commit commits.Commit
+		assert.Nil(t, decoder.Decode(&commit))
+		assert.Equal(t, expected.Sha, commit.Sha)
+		assert.Equal(t, expected.Author, commit.Author)
+	}
+	assert.False(t, decoder.More())
+}
+
+func TestParquetWriter(t *testing.T) {
+	for _, rows := range []int{0, len(testCommits)} {
+		out := new(bytes.Buffer)
+		writer, err := NewWriter(FormatParquet, out)
+		assert.Nil(t, err)
+		for _, commit := range testCommits[:rows] {
+			assert.Nil(t, writer.Write(commit))
+		}
+		assert.Nil(t, writer.Close())
+
+		file, err := buffer.NewBufferFile(out.Bytes())
+		assert.Nil(t, err)
+		parquetReader, err := reader.NewParquetReader(file, new(parquetRow), 1)
+		assert.Nil(t, err)
+
+		// The schema of the footer holds the columns in the order of the other formats, under the root.
+		var names []string
+		for _, info := range parquetReader.SchemaHandler.Infos[1:] {
+			names = append(names, info.ExName)
+		}
+		assert.Equal(t, columns, names)
+		assert.Equal(t, int64(rows), parquetReader.GetNumRows())
+
+		decoded := make([]parquetRow, rows)
+		assert.Nil(t, parquetReader.Read(&decoded))
+		parquetReader.ReadStop()
+		if rows > 0 {
+			assert.Equal(t, parquetRow{
+				Sha:             "fc4a5a4cf1a0fe4ea1c6ed5ae8d5d0fb0f0b4a01",
+				OwnerName:       "chromium",
+				RepoName:        "chromium",
+				Author:          "Marc Treib",
+				Message:         testCommits[0].Message,
+				ParentCommitIDs: "a70fc91846eaa0da2db1de18b8f344b485eb7996",
+				Additions:       12,
+				Deletions:       3,
+			}, decoded[0])
+			assert.Equal(t, "Sophie Chang", decoded[1].Author)
+			assert.Equal(t, int64(0), decoded[1].Additions)
+		}
+	}
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	_, err := NewWriter("xlsx", new(bytes.Buffer))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
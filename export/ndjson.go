@@ -0,0 +1,24 @@
+package export
+
+import (
+	"encoding/json"
+	"gitbeam/api/pb/commits"
+	"io"
+)
+
+type ndjsonWriter struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	return &ndjsonWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write encodes the commit as it is returned by the JSON API, one commit per line.
+func (n *ndjsonWriter) Write(commit *commits.Commit) error {
+	return n.encoder.Encode(commit)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
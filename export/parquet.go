@@ -0,0 +1,68 @@
+package export
+
+import (
+	"gitbeam/api/pb/commits"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"io"
+	"strings"
+)
+
+// parquetRowGroupSize bounds how many bytes of rows are buffered before a row group is flushed, so memory
+// stays bounded however many commits are exported.
+const parquetRowGroupSize = 16 * 1024 * 1024
+
+// parquetRow is a commit laid out as the columns of an export, lists are joined by commas.
+type parquetRow struct {
+	Sha             string `parquet:"name=sha, type=BYTE_ARRAY, convertedtype=UTF8"`
+	OwnerName       string `parquet:"name=ownerName, type=BYTE_ARRAY, convertedtype=UTF8"`
+	RepoName        string `parquet:"name=repoName, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Date            string `parquet:"name=date, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Author          string `parquet:"name=author, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AuthorEmail     string `parquet:"name=authorEmail, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AuthorLogin     string `parquet:"name=authorLogin, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Message         string `parquet:"name=message, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Url             string `parquet:"name=url, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ParentCommitIDs string `parquet:"name=parentCommitIDs, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Branches        string `parquet:"name=branches, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Additions       int64  `parquet:"name=additions, type=INT64"`
+	Deletions       int64  `parquet:"name=deletions, type=INT64"`
+}
+
+type parquetWriter struct {
+	writer *writer.ParquetWriter
+}
+
+func newParquetWriter(w io.Writer) (*parquetWriter, error) {
+	rowWriter, err := writer.NewParquetWriterFromWriter(w, new(parquetRow), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	rowWriter.RowGroupSize = parquetRowGroupSize
+	rowWriter.CompressionType = parquet.CompressionCodec_SNAPPY
+	return &parquetWriter{writer: rowWriter}, nil
+}
+
+func (p *parquetWriter) Write(commit *commits.Commit) error {
+	return p.writer.Write(parquetRow{
+		Sha:             commit.GetSha(),
+		OwnerName:       commit.GetOwnerName(),
+		RepoName:        commit.GetRepoName(),
+		Date:            commit.GetDate(),
+		Author:          commit.GetAuthor(),
+		AuthorEmail:     commit.GetAuthorEmail(),
+		AuthorLogin:     commit.GetAuthorLogin(),
+		Message:         commit.GetMessage(),
+		Url:             commit.GetUrl(),
+		ParentCommitIDs: strings.Join(commit.GetParentCommitIDs(), ","),
+		Branches:        strings.Join(commit.GetBranches(), ","),
+		Additions:       commit.GetStats().GetAdditions(),
+		Deletions:       commit.GetStats().GetDeletions(),
+	})
+}
+
+// Close flushes the last row group and writes the footer.
+func (p *parquetWriter) Close() error {
+	return p.writer.WriteStop()
+}
@@ -8,6 +8,7 @@ import (
 	"gitbeam/api/pb/commits"
 	gitRepos "gitbeam/api/pb/repos"
 	"gitbeam/config"
+	"gitbeam/manifest"
 	"github.com/go-chi/chi/v5"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/sirupsen/logrus"
@@ -48,7 +49,22 @@ func main() {
 		logger.WithError(err).Fatal("failed to connect to commits RPC server")
 	}
 
-	api.New(commitsServiceRPC, repoServiceRPC, logger).Routes(router)
+	gateway := api.New(commitsServiceRPC, repoServiceRPC, logger)
+	if secrets.MonitoringConfigPath != "" {
+		reconciler := manifest.NewReconciler(
+			secrets.MonitoringConfigPath,
+			secrets.MonitoringConfigPollInterval,
+			manifest.NewApplier(commitsServiceRPC, repoServiceRPC),
+			logger,
+		)
+		gateway.WithReconciler(reconciler)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go reconciler.Run(ctx)
+	}
+
+	gateway.Routes(router)
 	startAndManageHTTPServer(router, secrets.Port, logger)
 }
 
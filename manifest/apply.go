@@ -0,0 +1,233 @@
+package manifest
+
+import (
+	"context"
+	"gitbeam/api/pb/commits"
+	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/models"
+	"sync"
+)
+
+const (
+	ActionStart = "start"
+	ActionStop  = "stop"
+
+	DefaultConcurrency = 8
+	MaxConcurrency     = models.MaxBulkConcurrency
+)
+
+type Options struct {
+	// Sync also stops monitoring the repos that are monitored but not listed in the manifest.
+	Sync bool
+	// Concurrency bounds how many repos are started or stopped at once, defaults to DefaultConcurrency.
+	Concurrency int
+}
+
+// Result is the outcome of starting or stopping the monitoring of one repo.
+type Result struct {
+	OwnerName string `json:"ownerName"`
+	RepoName  string `json:"repoName"`
+	Action    string `json:"action"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Results []Result `json:"results"`
+	Failed  int      `json:"failed"`
+}
+
+// Drift is how the repos the commit monitor is monitoring differ from a manifest.
+type Drift struct {
+	// Missing repos are listed in the manifest but not monitored.
+	Missing []Repo `json:"missing"`
+	// Changed repos are monitored with another config than the one listed, which is what they hold.
+	Changed []Repo `json:"changed"`
+	// Unlisted repos are monitored but not listed in the manifest, e.g. the ones started through the API.
+	Unlisted []Repo `json:"unlisted"`
+	// Released repos are still monitored, though an earlier manifest listed them and this one dropped them.
+	Released []Repo `json:"released"`
+}
+
+// InSync tells whether every repo of the manifest is monitored as listed, and the ones it dropped are
+// stopped. Unlisted repos don't count, the manifest doesn't own them.
+func (d Drift) InSync() bool {
+	return len(d.Missing) == 0 && len(d.Changed) == 0 && len(d.Released) == 0
+}
+
+// release moves the unlisted repos that are owned, the ones an earlier manifest listed, to Released.
+func (d *Drift) release(owned []Repo) {
+	isOwned := make(map[string]bool, len(owned))
+	for _, repo := range owned {
+		isOwned[repo.key()] = true
+	}
+
+	unlisted := []Repo{}
+	for _, repo := range d.Unlisted {
+		if isOwned[repo.key()] {
+			d.Released = append(d.Released, repo)
+		} else {
+			unlisted = append(unlisted, repo)
+		}
+	}
+	d.Unlisted = unlisted
+}
+
+type change struct {
+	action string
+	repo   Repo
+}
+
+// Applier starts and stops the monitoring of repos so the commit monitor matches a manifest.
+type Applier struct {
+	commitsRPC commits.GitBeamCommitsServiceClient
+	reposRPC   gitRepos.GitBeamRepositoryServiceClient
+}
+
+func NewApplier(commitsRPC commits.GitBeamCommitsServiceClient, reposRPC gitRepos.GitBeamRepositoryServiceClient) *Applier {
+	return &Applier{commitsRPC: commitsRPC, reposRPC: reposRPC}
+}
+
+// Apply starts monitoring every repo of the manifest, and with opts.Sync stops monitoring the ones it
+// doesn't list. A repo failing doesn't stop the others, its error is reported in its Result.
+func (a *Applier) Apply(ctx context.Context, m Manifest, opts Options) (Report, error) {
+	changes := make([]change, 0, len(m.Repos))
+	for _, repo := range m.Repos {
+		changes = append(changes, change{action: ActionStart, repo: repo})
+	}
+
+	if opts.Sync {
+		drift, err := a.Drift(ctx, m)
+		if err != nil {
+			return Report{}, err
+		}
+
+		for _, repo := range drift.Unlisted {
+			changes = append(changes, change{action: ActionStop, repo: repo})
+		}
+	}
+
+	return a.run(ctx, changes, opts.Concurrency), nil
+}
+
+// Drift compares the monitoring configs of the commit monitor with the manifest. The commit monitor is
+// the source of truth of what is monitored, for Apply and Reconcile too.
+func (a *Applier) Drift(ctx context.Context, m Manifest) (Drift, error) {
+	list, err := a.commitsRPC.ListMonitoredRepositories(ctx, &commits.Void{})
+	if err != nil {
+		return Drift{}, err
+	}
+
+	monitored := make(map[string]Repo, len(list.GetData()))
+	for _, params := range list.GetData() {
+		repo := repoFromParams(params)
+		monitored[repo.key()] = repo
+	}
+
+	drift := Drift{Missing: []Repo{}, Changed: []Repo{}, Unlisted: []Repo{}, Released: []Repo{}}
+	listed := make(map[string]bool, len(m.Repos))
+	for _, repo := range m.Repos {
+		listed[repo.key()] = true
+		current, ok := monitored[repo.key()]
+		switch {
+		case !ok:
+			drift.Missing = append(drift.Missing, repo)
+		case !repo.equal(current):
+			drift.Changed = append(drift.Changed, repo)
+		}
+	}
+
+	for _, params := range list.GetData() {
+		if repo := repoFromParams(params); !listed[repo.key()] {
+			drift.Unlisted = append(drift.Unlisted, repo)
+		}
+	}
+
+	return drift, nil
+}
+
+// Reconcile only starts the repos of the manifest that are missing or changed. Of the unlisted repos, it
+// only stops the owned ones, the repos an earlier manifest listed, and leaves the others be.
+func (a *Applier) Reconcile(ctx context.Context, m Manifest, owned []Repo, concurrency int) (Report, error) {
+	drift, err := a.Drift(ctx, m)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var changes []change
+	for _, repo := range append(drift.Missing, drift.Changed...) {
+		changes = append(changes, change{action: ActionStart, repo: repo})
+	}
+
+	drift.release(owned)
+	for _, repo := range drift.Released {
+		changes = append(changes, change{action: ActionStop, repo: repo})
+	}
+
+	return a.run(ctx, changes, concurrency), nil
+}
+
+// run applies the changes with at most concurrency of them in flight, results keep the order of changes.
+func (a *Applier) run(ctx context.Context, changes []change, concurrency int) Report {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	if concurrency > MaxConcurrency {
+		concurrency = MaxConcurrency
+	}
+
+	report := Report{Results: make([]Result, len(changes))}
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, c := range changes {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, c change) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			result := Result{OwnerName: c.repo.OwnerName, RepoName: c.repo.RepoName, Action: c.action, Success: true}
+			if err := a.apply(ctx, c); err != nil {
+				result.Success, result.Error = false, err.Error()
+			}
+			report.Results[i] = result
+		}(i, c)
+	}
+	wg.Wait()
+
+	for _, result := range report.Results {
+		if !result.Success {
+			report.Failed++
+		}
+	}
+
+	return report
+}
+
+func (a *Applier) apply(ctx context.Context, c change) error {
+	if c.action == ActionStop {
+		_, err := a.commitsRPC.StopMonitoringRepositoryCommits(ctx, &commits.StopMonitoringRepositoryCommitParams{
+			OwnerName: c.repo.OwnerName,
+			RepoName:  c.repo.RepoName,
+		})
+		return err
+	}
+
+	repo, err := a.reposRPC.GetGitRepo(ctx, &gitRepos.GetGitRepoRequest{
+		OwnerName: c.repo.OwnerName,
+		RepoName:  c.repo.RepoName,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Mirror under the current names of the repo, the manifest may list the ones it went by before a
+	// rename or transfer.
+	params := c.repo.Params()
+	params.OwnerName, params.RepoName = repo.GetOwner(), repo.GetName()
+	_, err = a.commitsRPC.StartMonitoringRepositoryCommits(ctx, params)
+	return err
+}
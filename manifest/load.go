@@ -0,0 +1,100 @@
+package manifest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// manifestExtensions are the files read from a config directory.
+var manifestExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+type source struct {
+	name    string
+	content []byte
+}
+
+// Load reads the manifest at path, which is either a manifest file or a directory of them whose repos
+// are merged into one manifest.
+func Load(path string) (Manifest, error) {
+	sources, err := readSources(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	return parseSources(sources)
+}
+
+// readSources reads the manifest files at path, directories in file name order.
+func readSources(path string) ([]source, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return []source{{name: path, content: content}}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []source
+	for _, entry := range entries {
+		if entry.IsDir() || !manifestExtensions[filepath.Ext(entry.Name())] {
+			continue
+		}
+
+		name := filepath.Join(path, entry.Name())
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, source{name: name, content: content})
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].name < sources[j].name
+	})
+
+	return sources, nil
+}
+
+func parseSources(sources []source) (Manifest, error) {
+	var merged Manifest
+	for _, s := range sources {
+		m, err := Parse(bytes.NewReader(s.content))
+		if err != nil {
+			return Manifest{}, fmt.Errorf("%s: %w", s.name, err)
+		}
+
+		merged.Repos = append(merged.Repos, m.Repos...)
+	}
+
+	return merged, merged.Validate()
+}
+
+// fingerprint changes whenever a manifest file is added, removed or edited.
+func fingerprint(sources []source) string {
+	hash := sha256.New()
+	for _, s := range sources {
+		hash.Write([]byte(s.name))
+		hash.Write([]byte{0})
+		hash.Write(s.content)
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,156 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"gitbeam/api/pb/commits"
+	"gitbeam/models"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"gopkg.in/yaml.v3"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Manifest lists the repos whose commits should be monitored, and how.
+type Manifest struct {
+	Repos []Repo `json:"repos" yaml:"repos"`
+}
+
+// Repo is the monitoring config of a repo, the same as the payload of POST /commits/start-monitoring.
+type Repo struct {
+	OwnerName       string   `json:"ownerName" yaml:"ownerName"`
+	RepoName        string   `json:"repoName" yaml:"repoName"`
+	FromDate        string   `json:"fromDate,omitempty" yaml:"fromDate,omitempty"`
+	ToDate          string   `json:"toDate,omitempty" yaml:"toDate,omitempty"`
+	DurationInHours int64    `json:"durationInHours,omitempty" yaml:"durationInHours,omitempty"`
+	Branches        []string `json:"branches,omitempty" yaml:"branches,omitempty"`
+	EnrichDiffStats bool     `json:"enrichDiffStats,omitempty" yaml:"enrichDiffStats,omitempty"`
+	Webhooks        []string `json:"webhooks,omitempty" yaml:"webhooks,omitempty"`
+}
+
+var ErrEmptyManifest = errors.New("manifest lists no repos")
+
+// Parse reads a YAML manifest, JSON being a subset of YAML, JSON manifests are read too.
+func Parse(r io.Reader) (Manifest, error) {
+	var m Manifest
+	if err := yaml.NewDecoder(r).Decode(&m); err != nil {
+		if errors.Is(err, io.EOF) {
+			return m, ErrEmptyManifest
+		}
+
+		return m, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	return m, m.Validate()
+}
+
+// Validate checks every repo is monitored the way POST /commits/start-monitoring accepts, and is only
+// listed once. The errors of each repo are keyed by its index, e.g. repos[2].
+func (m Manifest) Validate() error {
+	if len(m.Repos) == 0 {
+		return ErrEmptyManifest
+	}
+
+	errs := validation.Errors{}
+	seen := make(map[string]bool, len(m.Repos))
+	for i, repo := range m.Repos {
+		if err := repo.Validate(); err != nil {
+			errs[fmt.Sprintf("repos[%d]", i)] = err
+			continue
+		}
+
+		if seen[repo.key()] {
+			errs[fmt.Sprintf("repos[%d]", i)] = fmt.Errorf("%s is listed more than once", repo.key())
+		}
+		seen[repo.key()] = true
+	}
+
+	return errs.Filter()
+}
+
+// Validate checks the repo with the rules of the start monitoring payload.
+func (r Repo) Validate() error {
+	request := models.MirrorRepoCommitsRequest{
+		OwnerAndRepoName: models.OwnerAndRepoName{OwnerName: r.OwnerName, RepoName: r.RepoName},
+		Branches:         r.Branches,
+		EnrichDiffStats:  r.EnrichDiffStats,
+		Webhooks:         r.Webhooks,
+	}
+
+	// Manifests leave durationInHours out, or at 0, for the default.
+	if r.DurationInHours != 0 {
+		request.DurationInHours = &r.DurationInHours
+	}
+
+	dateErrors := validation.Errors{}
+	var err error
+	if r.FromDate != "" {
+		if request.FromDate, err = models.Parse(r.FromDate); err != nil {
+			dateErrors["fromDate"] = models.ErrInvalidDate
+		}
+	}
+
+	if r.ToDate != "" {
+		if request.ToDate, err = models.Parse(r.ToDate); err != nil {
+			dateErrors["toDate"] = models.ErrInvalidDate
+		}
+	}
+
+	if len(dateErrors) > 0 {
+		return dateErrors
+	}
+
+	return request.Validate()
+}
+
+// Params returns the RPC params to start monitoring the repo with.
+func (r Repo) Params() *commits.MonitorRepositoryCommitsConfigParams {
+	return &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       r.OwnerName,
+		RepoName:        r.RepoName,
+		FromDate:        r.FromDate,
+		ToDate:          r.ToDate,
+		DurationInHours: r.DurationInHours,
+		Branches:        r.Branches,
+		EnrichDiffStats: r.EnrichDiffStats,
+		Webhooks:        r.Webhooks,
+	}
+}
+
+func repoFromParams(params *commits.MonitorRepositoryCommitsConfigParams) Repo {
+	return Repo{
+		OwnerName:       params.GetOwnerName(),
+		RepoName:        params.GetRepoName(),
+		FromDate:        params.GetFromDate(),
+		ToDate:          params.GetToDate(),
+		DurationInHours: params.GetDurationInHours(),
+		Branches:        params.GetBranches(),
+		EnrichDiffStats: params.GetEnrichDiffStats(),
+		Webhooks:        params.GetWebhooks(),
+	}
+}
+
+// equal tells whether two configs of the same repo monitor it the same way.
+func (r Repo) equal(other Repo) bool {
+	return r.key() == other.key() &&
+		r.FromDate == other.FromDate &&
+		r.ToDate == other.ToDate &&
+		r.DurationInHours == other.DurationInHours &&
+		sameSet(r.Branches, other.Branches) &&
+		r.EnrichDiffStats == other.EnrichDiffStats &&
+		sameSet(r.Webhooks, other.Webhooks)
+}
+
+// sameSet tells whether a and b hold the same values, in any order.
+func sameSet(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// key identifies the repo, GitHub owner and repo names are case insensitive.
+func (r Repo) key() string {
+	return strings.ToLower(r.OwnerName + "/" + r.RepoName)
+}
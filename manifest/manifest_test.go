@@ -0,0 +1,282 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"gitbeam/api/pb/commits"
+	gitRepos "gitbeam/api/pb/repos"
+	"gitbeam/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	m, err := Parse(strings.NewReader(`
+repos:
+  - ownerName: chromium
+    repoName: chromium
+    durationInHours: 1
+    branches: [main, release/*]
+    enrichDiffStats: true
+  - ownerName: brave
+    repoName: brave-browser
+    fromDate: "2024-07-01"
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, []Repo{
+		{OwnerName: "chromium", RepoName: "chromium", DurationInHours: 1, Branches: []string{"main", "release/*"}, EnrichDiffStats: true},
+		{OwnerName: "brave", RepoName: "brave-browser", FromDate: "2024-07-01"},
+	}, m.Repos)
+
+	m, err = Parse(strings.NewReader(`{"repos": [{"ownerName": "chromium", "repoName": "chromium"}]}`))
+	assert.Nil(t, err)
+	assert.Len(t, m.Repos, 1)
+
+	_, err = Parse(strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrEmptyManifest)
+
+	_, err = Parse(strings.NewReader(`{"repos": [{"ownerName": "chromium"}]}`))
+	assert.EqualError(t, err, "repos[0]: (repoName: cannot be blank.).")
+
+	_, err = Parse(strings.NewReader(`{"repos": [{"ownerName": "chromium", "repoName": "chromium", "fromDate": "2024-07-32", "durationInHours": -1}]}`))
+	assert.EqualError(t, err, "repos[0]: (fromDate: must be a valid date, e.g. 2024-07-01.).")
+
+	_, err = Parse(strings.NewReader(`{"repos": [{"ownerName": "chromium", "repoName": "chromium", "durationInHours": -1, "webhooks": ["ftp://example.com"]}]}`))
+	assert.EqualError(t, err, "repos[0]: (durationInHours: must be no less than 1; webhooks: (0: must be an http or https url.).).")
+
+	_, err = Parse(strings.NewReader(`{"repos": [{"ownerName": "chromium", "repoName": "chromium"}, {"ownerName": "Chromium", "repoName": "Chromium"}]}`))
+	assert.ErrorContains(t, err, "listed more than once")
+}
+
+func TestApply(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+
+	mockCommitsRPC.EXPECT().ListMonitoredRepositories(gomock.Any(), &commits.Void{}).Times(1).Return(&commits.ListMonitoredRepositoriesResponse{
+		Data: []*commits.MonitorRepositoryCommitsConfigParams{
+			{OwnerName: "chromium", RepoName: "chromium"},
+			{OwnerName: "mozilla", RepoName: "gecko-dev"},
+		},
+	}, nil)
+
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(_ context.Context, in *gitRepos.GetGitRepoRequest, _ ...grpc.CallOption) (*gitRepos.Repo, error) {
+			if in.GetRepoName() != "chromium" {
+				return nil, errors.New("repo not found")
+			}
+
+			return &gitRepos.Repo{Owner: in.GetOwnerName(), Name: in.GetRepoName()}, nil
+		})
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       "chromium",
+		RepoName:        "chromium",
+		DurationInHours: 1,
+	}).Return(&commits.Void{}, nil)
+	mockCommitsRPC.EXPECT().StopMonitoringRepositoryCommits(gomock.Any(), &commits.StopMonitoringRepositoryCommitParams{
+		OwnerName: "mozilla",
+		RepoName:  "gecko-dev",
+	}).Return(&commits.Void{}, nil)
+
+	m := Manifest{Repos: []Repo{
+		{OwnerName: "chromium", RepoName: "chromium", DurationInHours: 1},
+		{OwnerName: "brave", RepoName: "brave-browsr"},
+	}}
+	report, err := NewApplier(mockCommitsRPC, mockRepoRPC).Apply(context.Background(), m, Options{Sync: true, Concurrency: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, []Result{
+		{OwnerName: "chromium", RepoName: "chromium", Action: ActionStart, Success: true},
+		{OwnerName: "brave", RepoName: "brave-browsr", Action: ActionStart, Error: "repo not found"},
+		{OwnerName: "mozilla", RepoName: "gecko-dev", Action: ActionStop, Success: true},
+	}, report.Results)
+}
+
+func TestApplyRenamedRepo(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{OwnerName: "brave", RepoName: "browser-laptop"}).
+		Times(1).Return(&gitRepos.Repo{Owner: "brave", Name: "brave-browser"}, nil)
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       "brave",
+		RepoName:        "brave-browser",
+		DurationInHours: 1,
+	}).Times(1).Return(&commits.Void{}, nil)
+
+	m := Manifest{Repos: []Repo{{OwnerName: "brave", RepoName: "browser-laptop", DurationInHours: 1}}}
+	report, err := NewApplier(mockCommitsRPC, mockRepoRPC).Apply(context.Background(), m, Options{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, report.Failed)
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "chromium.yaml"), []byte("repos:\n  - ownerName: chromium\n    repoName: chromium\n"), 0o644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "brave.json"), []byte(`{"repos": [{"ownerName": "brave", "repoName": "brave-browser"}]}`), 0o644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Monitored repos"), 0o644))
+
+	m, err := Load(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, []Repo{
+		{OwnerName: "brave", RepoName: "brave-browser"},
+		{OwnerName: "chromium", RepoName: "chromium"},
+	}, m.Repos)
+
+	m, err = Load(filepath.Join(dir, "chromium.yaml"))
+	assert.Nil(t, err)
+	assert.Len(t, m.Repos, 1)
+
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "chromium-again.yml"), []byte("repos:\n  - ownerName: chromium\n    repoName: chromium\n"), 0o644))
+	_, err = Load(dir)
+	assert.ErrorContains(t, err, "listed more than once")
+}
+
+func TestReconciler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+
+	// The commit monitor watches chromium hourly, v8 and mozilla/gecko-dev, which was started through the
+	// API. The manifest wants chromium every 6 hours, v8 with the same branches listed in another order,
+	// and brave.
+	monitored := &commits.ListMonitoredRepositoriesResponse{
+		Data: []*commits.MonitorRepositoryCommitsConfigParams{
+			{OwnerName: "chromium", RepoName: "chromium", DurationInHours: 1},
+			{OwnerName: "v8", RepoName: "v8", Branches: []string{"main", "lkgr"}},
+			{OwnerName: "mozilla", RepoName: "gecko-dev", DurationInHours: 1},
+		},
+	}
+	mockCommitsRPC.EXPECT().ListMonitoredRepositories(gomock.Any(), &commits.Void{}).Return(monitored, nil).Times(3)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+		func(_ context.Context, in *gitRepos.GetGitRepoRequest, _ ...grpc.CallOption) (*gitRepos.Repo, error) {
+			return &gitRepos.Repo{Owner: in.GetOwnerName(), Name: in.GetRepoName()}, nil
+		})
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), gomock.Any()).Times(3).Return(&commits.Void{}, nil)
+	// Only chromium, once it's dropped from the manifest, is stopped.
+	mockCommitsRPC.EXPECT().StopMonitoringRepositoryCommits(gomock.Any(), &commits.StopMonitoringRepositoryCommitParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Times(1).Return(&commits.Void{}, nil)
+
+	path := filepath.Join(t.TempDir(), "gitbeam.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(`
+repos:
+  - ownerName: brave
+    repoName: brave-browser
+    webhooks: [https://hooks.example.com/gitbeam]
+  - ownerName: chromium
+    repoName: chromium
+    durationInHours: 6
+  - ownerName: v8
+    repoName: v8
+    branches: [lkgr, main]
+`), 0o644))
+
+	reconciler := NewReconciler(path, time.Minute, NewApplier(mockCommitsRPC, mockRepoRPC), logrus.New())
+	reconciler.check(context.Background())
+
+	status, err := reconciler.Status(context.Background())
+	assert.Nil(t, err)
+	assert.Empty(t, status.LastError)
+	assert.NotNil(t, status.LastReconciledAt)
+	assert.Equal(t, []Result{
+		{OwnerName: "brave", RepoName: "brave-browser", Action: ActionStart, Success: true},
+		{OwnerName: "chromium", RepoName: "chromium", Action: ActionStart, Success: true},
+	}, status.LastReport.Results)
+
+	// The mocked commit monitor didn't take the changes, so it still drifts from the manifest.
+	assert.False(t, status.InSync)
+	assert.Equal(t, "brave-browser", status.Drift.Missing[0].RepoName)
+	assert.Len(t, status.Drift.Changed, 1)
+	assert.Equal(t, int64(6), status.Drift.Changed[0].DurationInHours)
+	assert.Equal(t, "gecko-dev", status.Drift.Unlisted[0].RepoName)
+
+	// Unchanged manifests aren't reconciled again.
+	reconciler.check(context.Background())
+
+	assert.Nil(t, os.WriteFile(path, []byte(`
+repos:
+  - ownerName: brave
+    repoName: brave-browser
+  - ownerName: v8
+    repoName: v8
+    branches: [lkgr, main]
+`), 0o644))
+	reconciler.check(context.Background())
+
+	reconciler.mu.RLock()
+	report := reconciler.status.LastReport
+	reconciler.mu.RUnlock()
+	assert.Equal(t, []Result{
+		{OwnerName: "brave", RepoName: "brave-browser", Action: ActionStart, Success: true},
+		{OwnerName: "chromium", RepoName: "chromium", Action: ActionStop, Success: true},
+	}, report.Results)
+}
+
+func TestReconcilerAfterRestart(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockRepoRPC := mocks.NewMockGitBeamRepositoryServiceClient(controller)
+	mockCommitsRPC := mocks.NewMockGitBeamCommitsServiceClient(controller)
+	mockCommitsRPC.EXPECT().ListMonitoredRepositories(gomock.Any(), &commits.Void{}).Times(3).Return(
+		&commits.ListMonitoredRepositoriesResponse{
+			Data: []*commits.MonitorRepositoryCommitsConfigParams{
+				{OwnerName: "chromium", RepoName: "chromium", DurationInHours: 1},
+				{OwnerName: "mozilla", RepoName: "gecko-dev", DurationInHours: 1},
+			},
+		}, nil)
+	mockRepoRPC.EXPECT().GetGitRepo(gomock.Any(), &gitRepos.GetGitRepoRequest{OwnerName: "v8", RepoName: "v8"}).
+		Times(1).Return(&gitRepos.Repo{Owner: "v8", Name: "v8"}, nil)
+	mockCommitsRPC.EXPECT().StartMonitoringRepositoryCommits(gomock.Any(), &commits.MonitorRepositoryCommitsConfigParams{
+		OwnerName:       "v8",
+		RepoName:        "v8",
+		DurationInHours: 1,
+	}).Times(1).Return(&commits.Void{}, nil)
+	mockCommitsRPC.EXPECT().StopMonitoringRepositoryCommits(gomock.Any(), &commits.StopMonitoringRepositoryCommitParams{
+		OwnerName: "chromium",
+		RepoName:  "chromium",
+	}).Times(1).Return(nil, errors.New("commit monitor is unavailable"))
+
+	path := filepath.Join(t.TempDir(), "gitbeam.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("repos:\n  - ownerName: chromium\n    repoName: chromium\n    durationInHours: 1\n"), 0o644))
+	applier := NewApplier(mockCommitsRPC, mockRepoRPC)
+	NewReconciler(path, time.Minute, applier, logrus.New()).check(context.Background())
+
+	state, err := os.ReadFile(path + ownedSuffix)
+	assert.Nil(t, err)
+	assert.Contains(t, string(state), `"repoName": "chromium"`)
+
+	// chromium is dropped while the gateway is down, the next one still knows the manifest owned it.
+	assert.Nil(t, os.WriteFile(path, []byte("repos:\n  - ownerName: v8\n    repoName: v8\n    durationInHours: 1\n"), 0o644))
+	reconciler := NewReconciler(path, time.Minute, applier, logrus.New())
+	reconciler.loadOwned()
+	reconciler.check(context.Background())
+
+	status, err := reconciler.Status(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []Result{
+		{OwnerName: "v8", RepoName: "v8", Action: ActionStart, Success: true},
+		{OwnerName: "chromium", RepoName: "chromium", Action: ActionStop, Error: "commit monitor is unavailable"},
+	}, status.LastReport.Results)
+
+	// chromium failed to stop, so it's still owned and drifts as released, unlike mozilla/gecko-dev.
+	assert.False(t, status.InSync)
+	assert.Equal(t, "chromium", status.Drift.Released[0].RepoName)
+	assert.Len(t, status.Drift.Unlisted, 1)
+	assert.Equal(t, "gecko-dev", status.Drift.Unlisted[0].RepoName)
+}
@@ -0,0 +1,219 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+const DefaultPollInterval = 30 * time.Second
+
+// ownedSuffix is appended to the path of the manifest to name the state file holding the repos it owns.
+const ownedSuffix = ".owned.json"
+
+// Status is the outcome of the last reconciliation, along with the drift between the commit monitor and
+// the last manifest that was loaded successfully.
+type Status struct {
+	Path             string     `json:"path"`
+	Fingerprint      string     `json:"fingerprint"`
+	LastReconciledAt *time.Time `json:"lastReconciledAt,omitempty"`
+	LastError        string     `json:"lastError,omitempty"`
+	LastReport       *Report    `json:"lastReport,omitempty"`
+	InSync           bool       `json:"inSync"`
+	Drift            *Drift     `json:"drift,omitempty"`
+}
+
+// Reconciler keeps the commit monitor in line with the manifest file or directory at a path, reconciling
+// on start and whenever the manifest changes. Changes are polled for, so it works on any file system.
+//
+// The manifest doesn't own every monitored repo: repos started through the API are left be, and only the
+// ones dropped from the manifest are stopped. The owned repos are kept in a state file next to the
+// manifest, so the ones dropped while the gateway was down are stopped when it starts again.
+type Reconciler struct {
+	path      string
+	statePath string
+	interval  time.Duration
+	applier   *Applier
+	logger    *logrus.Logger
+
+	mu       sync.RWMutex
+	manifest *Manifest
+	// owned are the repos the manifest listed, which are stopped when they are dropped from it.
+	owned  []Repo
+	status Status
+}
+
+func NewReconciler(path string, interval time.Duration, applier *Applier, logger *logrus.Logger) *Reconciler {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	return &Reconciler{
+		path:      path,
+		statePath: filepath.Clean(path) + ownedSuffix,
+		interval:  interval,
+		applier:   applier,
+		logger:    logger.WithField("serviceName", "manifestReconciler").Logger,
+		status:    Status{Path: path},
+	}
+}
+
+// Run reconciles, then polls the manifest for changes until ctx is done.
+func (r *Reconciler) Run(ctx context.Context) {
+	r.loadOwned()
+	r.check(ctx)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx)
+		}
+	}
+}
+
+// check reconciles when the manifest changed since the last reconciliation, or when that one failed.
+func (r *Reconciler) check(ctx context.Context) {
+	sources, err := readSources(r.path)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+
+	current := fingerprint(sources)
+	r.mu.RLock()
+	upToDate := current == r.status.Fingerprint && r.status.LastError == ""
+	r.mu.RUnlock()
+	if upToDate {
+		return
+	}
+
+	m, err := parseSources(sources)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+
+	r.mu.RLock()
+	owned := r.owned
+	r.mu.RUnlock()
+	report, err := r.applier.Reconcile(ctx, m, owned, 0)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+
+	owned = stillOwned(m, report)
+	saveErr := r.saveOwned(owned)
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.manifest = &m
+	r.owned = owned
+	r.status.Fingerprint = current
+	r.status.LastReconciledAt = &now
+	r.status.LastReport = &report
+	r.status.LastError = ""
+	if report.Failed > 0 {
+		// Left as an error so the next poll retries.
+		r.status.LastError = fmt.Sprintf("%d of %d changes failed", report.Failed, len(report.Results))
+		r.logger.WithField("report", report).Error("failed to reconcile monitoring manifest")
+		return
+	}
+
+	if saveErr != nil {
+		// Left as an error too, a restart would forget which repos to stop otherwise.
+		r.status.LastError = saveErr.Error()
+		r.logger.WithError(saveErr).WithField("path", r.statePath).Error("failed to save the repos the monitoring manifest owns")
+		return
+	}
+
+	r.logger.WithField("changes", len(report.Results)).Info("reconciled monitoring manifest")
+}
+
+// stillOwned returns the repos of m, along with the dropped ones that failed to stop, so the next
+// reconciliation retries them.
+func stillOwned(m Manifest, report Report) []Repo {
+	owned := slices.Clone(m.Repos)
+	for _, result := range report.Results {
+		if result.Action == ActionStop && !result.Success {
+			owned = append(owned, Repo{OwnerName: result.OwnerName, RepoName: result.RepoName})
+		}
+	}
+
+	return owned
+}
+
+// loadOwned restores the repos the manifest owned before the gateway restarted, none on the first start.
+func (r *Reconciler) loadOwned() {
+	content, err := os.ReadFile(r.statePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+
+	var owned []Repo
+	if err == nil {
+		err = json.Unmarshal(content, &owned)
+	}
+
+	if err != nil {
+		r.logger.WithError(err).WithField("path", r.statePath).Error("failed to load the repos the monitoring manifest owns")
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.owned = owned
+}
+
+// saveOwned replaces the state file through a rename, so a crash never leaves half of it written.
+func (r *Reconciler) saveOwned(owned []Repo) error {
+	content, err := json.MarshalIndent(owned, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	temporary := r.statePath + ".tmp"
+	if err = os.WriteFile(temporary, content, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(temporary, r.statePath)
+}
+
+func (r *Reconciler) fail(err error) {
+	r.logger.WithError(err).WithField("path", r.path).Error("failed to reconcile monitoring manifest")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status.LastError = err.Error()
+}
+
+// Status returns the status of the last reconciliation, with the drift measured now.
+func (r *Reconciler) Status(ctx context.Context) (Status, error) {
+	r.mu.RLock()
+	status, m, owned := r.status, r.manifest, r.owned
+	r.mu.RUnlock()
+	if m == nil {
+		return status, nil
+	}
+
+	drift, err := r.applier.Drift(ctx, *m)
+	if err != nil {
+		return status, err
+	}
+
+	drift.release(owned)
+	status.Drift = &drift
+	status.InSync = drift.InSync()
+	return status, nil
+}
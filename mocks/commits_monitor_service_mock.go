@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockGitBeamCommitsServiceClient is a mock of GitBeamCommitsServiceClient interface.
@@ -36,6 +37,66 @@ func (m *MockGitBeamCommitsServiceClient) EXPECT() *MockGitBeamCommitsServiceCli
 	return m.recorder
 }
 
+// ExportCommits mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ExportCommits(ctx context.Context, in *commits.CommitFilterParams, opts ...grpc.CallOption) (commits.GitBeamCommitsService_ExportCommitsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportCommits", varargs...)
+	ret0, _ := ret[0].(commits.GitBeamCommitsService_ExportCommitsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCommits indicates an expected call of ExportCommits.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ExportCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ExportCommits), varargs...)
+}
+
+// GetAuthorAliases mocks base method.
+func (m *MockGitBeamCommitsServiceClient) GetAuthorAliases(ctx context.Context, in *commits.AuthorAliasesScope, opts ...grpc.CallOption) (*commits.AuthorAliasesConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAuthorAliases", varargs...)
+	ret0, _ := ret[0].(*commits.AuthorAliasesConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorAliases indicates an expected call of GetAuthorAliases.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) GetAuthorAliases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorAliases", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).GetAuthorAliases), varargs...)
+}
+
+// GetCommitActivity mocks base method.
+func (m *MockGitBeamCommitsServiceClient) GetCommitActivity(ctx context.Context, in *commits.CommitActivityParams, opts ...grpc.CallOption) (*commits.CommitActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommitActivity", varargs...)
+	ret0, _ := ret[0].(*commits.CommitActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitActivity indicates an expected call of GetCommitActivity.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) GetCommitActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitActivity", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).GetCommitActivity), varargs...)
+}
+
 // GetCommitByOwnerAndSHA mocks base method.
 func (m *MockGitBeamCommitsServiceClient) GetCommitByOwnerAndSHA(ctx context.Context, in *commits.CommitByOwnerAndShaParams, opts ...grpc.CallOption) (*commits.Commit, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +117,46 @@ func (mr *MockGitBeamCommitsServiceClientMockRecorder) GetCommitByOwnerAndSHA(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitByOwnerAndSHA", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).GetCommitByOwnerAndSHA), varargs...)
 }
 
+// GetIssuePatterns mocks base method.
+func (m *MockGitBeamCommitsServiceClient) GetIssuePatterns(ctx context.Context, in *commits.RepoScope, opts ...grpc.CallOption) (*commits.IssuePatternsConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIssuePatterns", varargs...)
+	ret0, _ := ret[0].(*commits.IssuePatternsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuePatterns indicates an expected call of GetIssuePatterns.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) GetIssuePatterns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuePatterns", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).GetIssuePatterns), varargs...)
+}
+
+// GetMergeBase mocks base method.
+func (m *MockGitBeamCommitsServiceClient) GetMergeBase(ctx context.Context, in *commits.CommitPairParams, opts ...grpc.CallOption) (*commits.Commit, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMergeBase", varargs...)
+	ret0, _ := ret[0].(*commits.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergeBase indicates an expected call of GetMergeBase.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) GetMergeBase(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergeBase", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).GetMergeBase), varargs...)
+}
+
 // HealthCheck mocks base method.
 func (m *MockGitBeamCommitsServiceClient) HealthCheck(ctx context.Context, in *commits.Void, opts ...grpc.CallOption) (*commits.HealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +177,66 @@ func (mr *MockGitBeamCommitsServiceClientMockRecorder) HealthCheck(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).HealthCheck), varargs...)
 }
 
+// IsAncestor mocks base method.
+func (m *MockGitBeamCommitsServiceClient) IsAncestor(ctx context.Context, in *commits.CommitPairParams, opts ...grpc.CallOption) (*commits.IsAncestorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsAncestor", varargs...)
+	ret0, _ := ret[0].(*commits.IsAncestorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAncestor indicates an expected call of IsAncestor.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) IsAncestor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAncestor", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).IsAncestor), varargs...)
+}
+
+// ListAncestors mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListAncestors(ctx context.Context, in *commits.CommitGraphParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAncestors", varargs...)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAncestors indicates an expected call of ListAncestors.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListAncestors(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAncestors", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListAncestors), varargs...)
+}
+
+// ListCommitTypeBreakdown mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListCommitTypeBreakdown(ctx context.Context, in *commits.CommitFilterParams, opts ...grpc.CallOption) (*commits.CommitTypeBreakdownResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCommitTypeBreakdown", varargs...)
+	ret0, _ := ret[0].(*commits.CommitTypeBreakdownResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitTypeBreakdown indicates an expected call of ListCommitTypeBreakdown.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListCommitTypeBreakdown(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitTypeBreakdown", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListCommitTypeBreakdown), varargs...)
+}
+
 // ListCommits mocks base method.
 func (m *MockGitBeamCommitsServiceClient) ListCommits(ctx context.Context, in *commits.CommitFilterParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,175 +257,1026 @@ func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListCommits(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListCommits), varargs...)
 }
 
-// ListTopCommitAuthor mocks base method.
-func (m *MockGitBeamCommitsServiceClient) ListTopCommitAuthor(ctx context.Context, in *commits.CommitFilterParams, opts ...grpc.CallOption) (*commits.ListTopCommitAuthorResponse, error) {
+// ListCommitsBetweenRefs mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListCommitsBetweenRefs(ctx context.Context, in *commits.CommitRangeParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListTopCommitAuthor", varargs...)
-	ret0, _ := ret[0].(*commits.ListTopCommitAuthorResponse)
+	ret := m.ctrl.Call(m, "ListCommitsBetweenRefs", varargs...)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTopCommitAuthor indicates an expected call of ListTopCommitAuthor.
-func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListTopCommitAuthor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListCommitsBetweenRefs indicates an expected call of ListCommitsBetweenRefs.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListCommitsBetweenRefs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopCommitAuthor", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListTopCommitAuthor), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitsBetweenRefs", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListCommitsBetweenRefs), varargs...)
 }
 
-// StartMonitoringRepositoryCommits mocks base method.
-func (m *MockGitBeamCommitsServiceClient) StartMonitoringRepositoryCommits(ctx context.Context, in *commits.MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*commits.Void, error) {
+// ListCommitsBetweenTags mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListCommitsBetweenTags(ctx context.Context, in *commits.CommitsBetweenTagsParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StartMonitoringRepositoryCommits", varargs...)
-	ret0, _ := ret[0].(*commits.Void)
+	ret := m.ctrl.Call(m, "ListCommitsBetweenTags", varargs...)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartMonitoringRepositoryCommits indicates an expected call of StartMonitoringRepositoryCommits.
-func (mr *MockGitBeamCommitsServiceClientMockRecorder) StartMonitoringRepositoryCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListCommitsBetweenTags indicates an expected call of ListCommitsBetweenTags.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListCommitsBetweenTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).StartMonitoringRepositoryCommits), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitsBetweenTags", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListCommitsBetweenTags), varargs...)
 }
 
-// StopMonitoringRepositoryCommits mocks base method.
-func (m *MockGitBeamCommitsServiceClient) StopMonitoringRepositoryCommits(ctx context.Context, in *commits.StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*commits.Void, error) {
+// ListDescendants mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListDescendants(ctx context.Context, in *commits.CommitGraphParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StopMonitoringRepositoryCommits", varargs...)
-	ret0, _ := ret[0].(*commits.Void)
+	ret := m.ctrl.Call(m, "ListDescendants", varargs...)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StopMonitoringRepositoryCommits indicates an expected call of StopMonitoringRepositoryCommits.
-func (mr *MockGitBeamCommitsServiceClientMockRecorder) StopMonitoringRepositoryCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListDescendants indicates an expected call of ListDescendants.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListDescendants(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).StopMonitoringRepositoryCommits), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDescendants", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListDescendants), varargs...)
 }
 
-// MockGitBeamCommitsServiceServer is a mock of GitBeamCommitsServiceServer interface.
-type MockGitBeamCommitsServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockGitBeamCommitsServiceServerMockRecorder
+// ListIssueCommits mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListIssueCommits(ctx context.Context, in *commits.IssueCommitsParams, opts ...grpc.CallOption) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIssueCommits", varargs...)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockGitBeamCommitsServiceServerMockRecorder is the mock recorder for MockGitBeamCommitsServiceServer.
-type MockGitBeamCommitsServiceServerMockRecorder struct {
-	mock *MockGitBeamCommitsServiceServer
+// ListIssueCommits indicates an expected call of ListIssueCommits.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListIssueCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListIssueCommits), varargs...)
 }
 
-// NewMockGitBeamCommitsServiceServer creates a new mock instance.
-func NewMockGitBeamCommitsServiceServer(ctrl *gomock.Controller) *MockGitBeamCommitsServiceServer {
-	mock := &MockGitBeamCommitsServiceServer{ctrl: ctrl}
-	mock.recorder = &MockGitBeamCommitsServiceServerMockRecorder{mock}
-	return mock
+// ListMonitoredRepositories mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListMonitoredRepositories(ctx context.Context, in *commits.Void, opts ...grpc.CallOption) (*commits.ListMonitoredRepositoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMonitoredRepositories", varargs...)
+	ret0, _ := ret[0].(*commits.ListMonitoredRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGitBeamCommitsServiceServer) EXPECT() *MockGitBeamCommitsServiceServerMockRecorder {
-	return m.recorder
+// ListMonitoredRepositories indicates an expected call of ListMonitoredRepositories.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListMonitoredRepositories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredRepositories", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListMonitoredRepositories), varargs...)
 }
 
-// GetCommitByOwnerAndSHA mocks base method.
-func (m *MockGitBeamCommitsServiceServer) GetCommitByOwnerAndSHA(arg0 context.Context, arg1 *commits.CommitByOwnerAndShaParams) (*commits.Commit, error) {
+// ListReleases mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListReleases(ctx context.Context, in *commits.RepoRefsParams, opts ...grpc.CallOption) (*commits.ListReleasesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommitByOwnerAndSHA", arg0, arg1)
-	ret0, _ := ret[0].(*commits.Commit)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReleases", varargs...)
+	ret0, _ := ret[0].(*commits.ListReleasesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCommitByOwnerAndSHA indicates an expected call of GetCommitByOwnerAndSHA.
-func (mr *MockGitBeamCommitsServiceServerMockRecorder) GetCommitByOwnerAndSHA(arg0, arg1 interface{}) *gomock.Call {
+// ListReleases indicates an expected call of ListReleases.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListReleases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitByOwnerAndSHA", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).GetCommitByOwnerAndSHA), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListReleases), varargs...)
 }
 
-// HealthCheck mocks base method.
-func (m *MockGitBeamCommitsServiceServer) HealthCheck(arg0 context.Context, arg1 *commits.Void) (*commits.HealthCheckResponse, error) {
+// ListTags mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListTags(ctx context.Context, in *commits.RepoRefsParams, opts ...grpc.CallOption) (*commits.ListTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HealthCheck", arg0, arg1)
-	ret0, _ := ret[0].(*commits.HealthCheckResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTags", varargs...)
+	ret0, _ := ret[0].(*commits.ListTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HealthCheck indicates an expected call of HealthCheck.
-func (mr *MockGitBeamCommitsServiceServerMockRecorder) HealthCheck(arg0, arg1 interface{}) *gomock.Call {
+// ListTags indicates an expected call of ListTags.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).HealthCheck), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListTags), varargs...)
 }
 
-// ListCommits mocks base method.
-func (m *MockGitBeamCommitsServiceServer) ListCommits(arg0 context.Context, arg1 *commits.CommitFilterParams) (*commits.ListCommitResponse, error) {
+// ListTopCommitAuthor mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListTopCommitAuthor(ctx context.Context, in *commits.CommitFilterParams, opts ...grpc.CallOption) (*commits.ListTopCommitAuthorResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCommits", arg0, arg1)
-	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTopCommitAuthor", varargs...)
+	ret0, _ := ret[0].(*commits.ListTopCommitAuthorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCommits indicates an expected call of ListCommits.
-func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListCommits(arg0, arg1 interface{}) *gomock.Call {
+// ListTopCommitAuthor indicates an expected call of ListTopCommitAuthor.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListTopCommitAuthor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListCommits), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopCommitAuthor", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListTopCommitAuthor), varargs...)
 }
 
-// ListTopCommitAuthor mocks base method.
-func (m *MockGitBeamCommitsServiceServer) ListTopCommitAuthor(arg0 context.Context, arg1 *commits.CommitFilterParams) (*commits.ListTopCommitAuthorResponse, error) {
+// ListTopReviewers mocks base method.
+func (m *MockGitBeamCommitsServiceClient) ListTopReviewers(ctx context.Context, in *commits.CommitFilterParams, opts ...grpc.CallOption) (*commits.ListTopReviewerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTopCommitAuthor", arg0, arg1)
-	ret0, _ := ret[0].(*commits.ListTopCommitAuthorResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTopReviewers", varargs...)
+	ret0, _ := ret[0].(*commits.ListTopReviewerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTopCommitAuthor indicates an expected call of ListTopCommitAuthor.
-func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListTopCommitAuthor(arg0, arg1 interface{}) *gomock.Call {
+// ListTopReviewers indicates an expected call of ListTopReviewers.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) ListTopReviewers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopCommitAuthor", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListTopCommitAuthor), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopReviewers", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).ListTopReviewers), varargs...)
 }
 
-// StartMonitoringRepositoryCommits mocks base method.
-func (m *MockGitBeamCommitsServiceServer) StartMonitoringRepositoryCommits(arg0 context.Context, arg1 *commits.MonitorRepositoryCommitsConfigParams) (*commits.Void, error) {
+// RenameRepository mocks base method.
+func (m *MockGitBeamCommitsServiceClient) RenameRepository(ctx context.Context, in *commits.RenameRepositoryParams, opts ...grpc.CallOption) (*commits.Void, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartMonitoringRepositoryCommits", arg0, arg1)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameRepository", varargs...)
 	ret0, _ := ret[0].(*commits.Void)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartMonitoringRepositoryCommits indicates an expected call of StartMonitoringRepositoryCommits.
-func (mr *MockGitBeamCommitsServiceServerMockRecorder) StartMonitoringRepositoryCommits(arg0, arg1 interface{}) *gomock.Call {
+// RenameRepository indicates an expected call of RenameRepository.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) RenameRepository(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).StartMonitoringRepositoryCommits), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameRepository", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).RenameRepository), varargs...)
 }
 
-// StopMonitoringRepositoryCommits mocks base method.
-func (m *MockGitBeamCommitsServiceServer) StopMonitoringRepositoryCommits(arg0 context.Context, arg1 *commits.StopMonitoringRepositoryCommitParams) (*commits.Void, error) {
+// SearchCommits mocks base method.
+func (m *MockGitBeamCommitsServiceClient) SearchCommits(ctx context.Context, in *commits.SearchCommitsParams, opts ...grpc.CallOption) (*commits.SearchCommitsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopMonitoringRepositoryCommits", arg0, arg1)
-	ret0, _ := ret[0].(*commits.Void)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchCommits", varargs...)
+	ret0, _ := ret[0].(*commits.SearchCommitsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StopMonitoringRepositoryCommits indicates an expected call of StopMonitoringRepositoryCommits.
-func (mr *MockGitBeamCommitsServiceServerMockRecorder) StopMonitoringRepositoryCommits(arg0, arg1 interface{}) *gomock.Call {
+// SearchCommits indicates an expected call of SearchCommits.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) SearchCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).StopMonitoringRepositoryCommits), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).SearchCommits), varargs...)
+}
+
+// SetAuthorAliases mocks base method.
+func (m *MockGitBeamCommitsServiceClient) SetAuthorAliases(ctx context.Context, in *commits.AuthorAliasesConfig, opts ...grpc.CallOption) (*commits.AuthorAliasesConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetAuthorAliases", varargs...)
+	ret0, _ := ret[0].(*commits.AuthorAliasesConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAuthorAliases indicates an expected call of SetAuthorAliases.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) SetAuthorAliases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuthorAliases", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).SetAuthorAliases), varargs...)
+}
+
+// SetIssuePatterns mocks base method.
+func (m *MockGitBeamCommitsServiceClient) SetIssuePatterns(ctx context.Context, in *commits.IssuePatternsConfig, opts ...grpc.CallOption) (*commits.IssuePatternsConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIssuePatterns", varargs...)
+	ret0, _ := ret[0].(*commits.IssuePatternsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIssuePatterns indicates an expected call of SetIssuePatterns.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) SetIssuePatterns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIssuePatterns", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).SetIssuePatterns), varargs...)
+}
+
+// StartMonitoringOwnerRepositories mocks base method.
+func (m *MockGitBeamCommitsServiceClient) StartMonitoringOwnerRepositories(ctx context.Context, in *commits.MonitorOwnerRepositoriesConfigParams, opts ...grpc.CallOption) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartMonitoringOwnerRepositories", varargs...)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMonitoringOwnerRepositories indicates an expected call of StartMonitoringOwnerRepositories.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) StartMonitoringOwnerRepositories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMonitoringOwnerRepositories", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).StartMonitoringOwnerRepositories), varargs...)
+}
+
+// StartMonitoringRepositoryCommits mocks base method.
+func (m *MockGitBeamCommitsServiceClient) StartMonitoringRepositoryCommits(ctx context.Context, in *commits.MonitorRepositoryCommitsConfigParams, opts ...grpc.CallOption) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartMonitoringRepositoryCommits", varargs...)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMonitoringRepositoryCommits indicates an expected call of StartMonitoringRepositoryCommits.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) StartMonitoringRepositoryCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).StartMonitoringRepositoryCommits), varargs...)
+}
+
+// StopMonitoringOwnerRepositories mocks base method.
+func (m *MockGitBeamCommitsServiceClient) StopMonitoringOwnerRepositories(ctx context.Context, in *commits.StopMonitoringOwnerRepositoriesParams, opts ...grpc.CallOption) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopMonitoringOwnerRepositories", varargs...)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMonitoringOwnerRepositories indicates an expected call of StopMonitoringOwnerRepositories.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) StopMonitoringOwnerRepositories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMonitoringOwnerRepositories", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).StopMonitoringOwnerRepositories), varargs...)
+}
+
+// StopMonitoringRepositoryCommits mocks base method.
+func (m *MockGitBeamCommitsServiceClient) StopMonitoringRepositoryCommits(ctx context.Context, in *commits.StopMonitoringRepositoryCommitParams, opts ...grpc.CallOption) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopMonitoringRepositoryCommits", varargs...)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMonitoringRepositoryCommits indicates an expected call of StopMonitoringRepositoryCommits.
+func (mr *MockGitBeamCommitsServiceClientMockRecorder) StopMonitoringRepositoryCommits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceClient)(nil).StopMonitoringRepositoryCommits), varargs...)
+}
+
+// MockGitBeamCommitsService_ExportCommitsClient is a mock of GitBeamCommitsService_ExportCommitsClient interface.
+type MockGitBeamCommitsService_ExportCommitsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitBeamCommitsService_ExportCommitsClientMockRecorder
+}
+
+// MockGitBeamCommitsService_ExportCommitsClientMockRecorder is the mock recorder for MockGitBeamCommitsService_ExportCommitsClient.
+type MockGitBeamCommitsService_ExportCommitsClientMockRecorder struct {
+	mock *MockGitBeamCommitsService_ExportCommitsClient
+}
+
+// NewMockGitBeamCommitsService_ExportCommitsClient creates a new mock instance.
+func NewMockGitBeamCommitsService_ExportCommitsClient(ctrl *gomock.Controller) *MockGitBeamCommitsService_ExportCommitsClient {
+	mock := &MockGitBeamCommitsService_ExportCommitsClient{ctrl: ctrl}
+	mock.recorder = &MockGitBeamCommitsService_ExportCommitsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitBeamCommitsService_ExportCommitsClient) EXPECT() *MockGitBeamCommitsService_ExportCommitsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsClient) Recv() (*commits.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*commits.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockGitBeamCommitsService_ExportCommitsClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockGitBeamCommitsService_ExportCommitsClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockGitBeamCommitsService_ExportCommitsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsClient)(nil).Trailer))
+}
+
+// MockGitBeamCommitsServiceServer is a mock of GitBeamCommitsServiceServer interface.
+type MockGitBeamCommitsServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitBeamCommitsServiceServerMockRecorder
+}
+
+// MockGitBeamCommitsServiceServerMockRecorder is the mock recorder for MockGitBeamCommitsServiceServer.
+type MockGitBeamCommitsServiceServerMockRecorder struct {
+	mock *MockGitBeamCommitsServiceServer
+}
+
+// NewMockGitBeamCommitsServiceServer creates a new mock instance.
+func NewMockGitBeamCommitsServiceServer(ctrl *gomock.Controller) *MockGitBeamCommitsServiceServer {
+	mock := &MockGitBeamCommitsServiceServer{ctrl: ctrl}
+	mock.recorder = &MockGitBeamCommitsServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitBeamCommitsServiceServer) EXPECT() *MockGitBeamCommitsServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ExportCommits mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ExportCommits(arg0 *commits.CommitFilterParams, arg1 commits.GitBeamCommitsService_ExportCommitsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCommits", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCommits indicates an expected call of ExportCommits.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ExportCommits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ExportCommits), arg0, arg1)
+}
+
+// GetAuthorAliases mocks base method.
+func (m *MockGitBeamCommitsServiceServer) GetAuthorAliases(arg0 context.Context, arg1 *commits.AuthorAliasesScope) (*commits.AuthorAliasesConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorAliases", arg0, arg1)
+	ret0, _ := ret[0].(*commits.AuthorAliasesConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorAliases indicates an expected call of GetAuthorAliases.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) GetAuthorAliases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorAliases", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).GetAuthorAliases), arg0, arg1)
+}
+
+// GetCommitActivity mocks base method.
+func (m *MockGitBeamCommitsServiceServer) GetCommitActivity(arg0 context.Context, arg1 *commits.CommitActivityParams) (*commits.CommitActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitActivity", arg0, arg1)
+	ret0, _ := ret[0].(*commits.CommitActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitActivity indicates an expected call of GetCommitActivity.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) GetCommitActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitActivity", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).GetCommitActivity), arg0, arg1)
+}
+
+// GetCommitByOwnerAndSHA mocks base method.
+func (m *MockGitBeamCommitsServiceServer) GetCommitByOwnerAndSHA(arg0 context.Context, arg1 *commits.CommitByOwnerAndShaParams) (*commits.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitByOwnerAndSHA", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitByOwnerAndSHA indicates an expected call of GetCommitByOwnerAndSHA.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) GetCommitByOwnerAndSHA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitByOwnerAndSHA", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).GetCommitByOwnerAndSHA), arg0, arg1)
+}
+
+// GetIssuePatterns mocks base method.
+func (m *MockGitBeamCommitsServiceServer) GetIssuePatterns(arg0 context.Context, arg1 *commits.RepoScope) (*commits.IssuePatternsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssuePatterns", arg0, arg1)
+	ret0, _ := ret[0].(*commits.IssuePatternsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuePatterns indicates an expected call of GetIssuePatterns.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) GetIssuePatterns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuePatterns", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).GetIssuePatterns), arg0, arg1)
+}
+
+// GetMergeBase mocks base method.
+func (m *MockGitBeamCommitsServiceServer) GetMergeBase(arg0 context.Context, arg1 *commits.CommitPairParams) (*commits.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMergeBase", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergeBase indicates an expected call of GetMergeBase.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) GetMergeBase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergeBase", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).GetMergeBase), arg0, arg1)
+}
+
+// HealthCheck mocks base method.
+func (m *MockGitBeamCommitsServiceServer) HealthCheck(arg0 context.Context, arg1 *commits.Void) (*commits.HealthCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheck", arg0, arg1)
+	ret0, _ := ret[0].(*commits.HealthCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) HealthCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).HealthCheck), arg0, arg1)
+}
+
+// IsAncestor mocks base method.
+func (m *MockGitBeamCommitsServiceServer) IsAncestor(arg0 context.Context, arg1 *commits.CommitPairParams) (*commits.IsAncestorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAncestor", arg0, arg1)
+	ret0, _ := ret[0].(*commits.IsAncestorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAncestor indicates an expected call of IsAncestor.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) IsAncestor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAncestor", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).IsAncestor), arg0, arg1)
+}
+
+// ListAncestors mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListAncestors(arg0 context.Context, arg1 *commits.CommitGraphParams) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAncestors", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAncestors indicates an expected call of ListAncestors.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListAncestors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAncestors", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListAncestors), arg0, arg1)
+}
+
+// ListCommitTypeBreakdown mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListCommitTypeBreakdown(arg0 context.Context, arg1 *commits.CommitFilterParams) (*commits.CommitTypeBreakdownResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommitTypeBreakdown", arg0, arg1)
+	ret0, _ := ret[0].(*commits.CommitTypeBreakdownResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitTypeBreakdown indicates an expected call of ListCommitTypeBreakdown.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListCommitTypeBreakdown(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitTypeBreakdown", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListCommitTypeBreakdown), arg0, arg1)
+}
+
+// ListCommits mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListCommits(arg0 context.Context, arg1 *commits.CommitFilterParams) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommits", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommits indicates an expected call of ListCommits.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListCommits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListCommits), arg0, arg1)
+}
+
+// ListCommitsBetweenRefs mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListCommitsBetweenRefs(arg0 context.Context, arg1 *commits.CommitRangeParams) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommitsBetweenRefs", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitsBetweenRefs indicates an expected call of ListCommitsBetweenRefs.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListCommitsBetweenRefs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitsBetweenRefs", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListCommitsBetweenRefs), arg0, arg1)
+}
+
+// ListCommitsBetweenTags mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListCommitsBetweenTags(arg0 context.Context, arg1 *commits.CommitsBetweenTagsParams) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommitsBetweenTags", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitsBetweenTags indicates an expected call of ListCommitsBetweenTags.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListCommitsBetweenTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitsBetweenTags", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListCommitsBetweenTags), arg0, arg1)
+}
+
+// ListDescendants mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListDescendants(arg0 context.Context, arg1 *commits.CommitGraphParams) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDescendants", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDescendants indicates an expected call of ListDescendants.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListDescendants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDescendants", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListDescendants), arg0, arg1)
+}
+
+// ListIssueCommits mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListIssueCommits(arg0 context.Context, arg1 *commits.IssueCommitsParams) (*commits.ListCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueCommits", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueCommits indicates an expected call of ListIssueCommits.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListIssueCommits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListIssueCommits), arg0, arg1)
+}
+
+// ListMonitoredRepositories mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListMonitoredRepositories(arg0 context.Context, arg1 *commits.Void) (*commits.ListMonitoredRepositoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMonitoredRepositories", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListMonitoredRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMonitoredRepositories indicates an expected call of ListMonitoredRepositories.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListMonitoredRepositories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredRepositories", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListMonitoredRepositories), arg0, arg1)
+}
+
+// ListReleases mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListReleases(arg0 context.Context, arg1 *commits.RepoRefsParams) (*commits.ListReleasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReleases", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListReleasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReleases indicates an expected call of ListReleases.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListReleases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListReleases), arg0, arg1)
+}
+
+// ListTags mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListTags(arg0 context.Context, arg1 *commits.RepoRefsParams) (*commits.ListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListTags), arg0, arg1)
+}
+
+// ListTopCommitAuthor mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListTopCommitAuthor(arg0 context.Context, arg1 *commits.CommitFilterParams) (*commits.ListTopCommitAuthorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopCommitAuthor", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListTopCommitAuthorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopCommitAuthor indicates an expected call of ListTopCommitAuthor.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListTopCommitAuthor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopCommitAuthor", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListTopCommitAuthor), arg0, arg1)
+}
+
+// ListTopReviewers mocks base method.
+func (m *MockGitBeamCommitsServiceServer) ListTopReviewers(arg0 context.Context, arg1 *commits.CommitFilterParams) (*commits.ListTopReviewerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopReviewers", arg0, arg1)
+	ret0, _ := ret[0].(*commits.ListTopReviewerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopReviewers indicates an expected call of ListTopReviewers.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) ListTopReviewers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopReviewers", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).ListTopReviewers), arg0, arg1)
+}
+
+// RenameRepository mocks base method.
+func (m *MockGitBeamCommitsServiceServer) RenameRepository(arg0 context.Context, arg1 *commits.RenameRepositoryParams) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameRepository", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameRepository indicates an expected call of RenameRepository.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) RenameRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameRepository", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).RenameRepository), arg0, arg1)
+}
+
+// SearchCommits mocks base method.
+func (m *MockGitBeamCommitsServiceServer) SearchCommits(arg0 context.Context, arg1 *commits.SearchCommitsParams) (*commits.SearchCommitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCommits", arg0, arg1)
+	ret0, _ := ret[0].(*commits.SearchCommitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCommits indicates an expected call of SearchCommits.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) SearchCommits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).SearchCommits), arg0, arg1)
+}
+
+// SetAuthorAliases mocks base method.
+func (m *MockGitBeamCommitsServiceServer) SetAuthorAliases(arg0 context.Context, arg1 *commits.AuthorAliasesConfig) (*commits.AuthorAliasesConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAuthorAliases", arg0, arg1)
+	ret0, _ := ret[0].(*commits.AuthorAliasesConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAuthorAliases indicates an expected call of SetAuthorAliases.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) SetAuthorAliases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuthorAliases", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).SetAuthorAliases), arg0, arg1)
+}
+
+// SetIssuePatterns mocks base method.
+func (m *MockGitBeamCommitsServiceServer) SetIssuePatterns(arg0 context.Context, arg1 *commits.IssuePatternsConfig) (*commits.IssuePatternsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIssuePatterns", arg0, arg1)
+	ret0, _ := ret[0].(*commits.IssuePatternsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIssuePatterns indicates an expected call of SetIssuePatterns.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) SetIssuePatterns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIssuePatterns", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).SetIssuePatterns), arg0, arg1)
+}
+
+// StartMonitoringOwnerRepositories mocks base method.
+func (m *MockGitBeamCommitsServiceServer) StartMonitoringOwnerRepositories(arg0 context.Context, arg1 *commits.MonitorOwnerRepositoriesConfigParams) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMonitoringOwnerRepositories", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMonitoringOwnerRepositories indicates an expected call of StartMonitoringOwnerRepositories.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) StartMonitoringOwnerRepositories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMonitoringOwnerRepositories", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).StartMonitoringOwnerRepositories), arg0, arg1)
+}
+
+// StartMonitoringRepositoryCommits mocks base method.
+func (m *MockGitBeamCommitsServiceServer) StartMonitoringRepositoryCommits(arg0 context.Context, arg1 *commits.MonitorRepositoryCommitsConfigParams) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMonitoringRepositoryCommits", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMonitoringRepositoryCommits indicates an expected call of StartMonitoringRepositoryCommits.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) StartMonitoringRepositoryCommits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).StartMonitoringRepositoryCommits), arg0, arg1)
+}
+
+// StopMonitoringOwnerRepositories mocks base method.
+func (m *MockGitBeamCommitsServiceServer) StopMonitoringOwnerRepositories(arg0 context.Context, arg1 *commits.StopMonitoringOwnerRepositoriesParams) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopMonitoringOwnerRepositories", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMonitoringOwnerRepositories indicates an expected call of StopMonitoringOwnerRepositories.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) StopMonitoringOwnerRepositories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMonitoringOwnerRepositories", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).StopMonitoringOwnerRepositories), arg0, arg1)
+}
+
+// StopMonitoringRepositoryCommits mocks base method.
+func (m *MockGitBeamCommitsServiceServer) StopMonitoringRepositoryCommits(arg0 context.Context, arg1 *commits.StopMonitoringRepositoryCommitParams) (*commits.Void, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopMonitoringRepositoryCommits", arg0, arg1)
+	ret0, _ := ret[0].(*commits.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMonitoringRepositoryCommits indicates an expected call of StopMonitoringRepositoryCommits.
+func (mr *MockGitBeamCommitsServiceServerMockRecorder) StopMonitoringRepositoryCommits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMonitoringRepositoryCommits", reflect.TypeOf((*MockGitBeamCommitsServiceServer)(nil).StopMonitoringRepositoryCommits), arg0, arg1)
+}
+
+// MockGitBeamCommitsService_ExportCommitsServer is a mock of GitBeamCommitsService_ExportCommitsServer interface.
+type MockGitBeamCommitsService_ExportCommitsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitBeamCommitsService_ExportCommitsServerMockRecorder
+}
+
+// MockGitBeamCommitsService_ExportCommitsServerMockRecorder is the mock recorder for MockGitBeamCommitsService_ExportCommitsServer.
+type MockGitBeamCommitsService_ExportCommitsServerMockRecorder struct {
+	mock *MockGitBeamCommitsService_ExportCommitsServer
+}
+
+// NewMockGitBeamCommitsService_ExportCommitsServer creates a new mock instance.
+func NewMockGitBeamCommitsService_ExportCommitsServer(ctrl *gomock.Controller) *MockGitBeamCommitsService_ExportCommitsServer {
+	mock := &MockGitBeamCommitsService_ExportCommitsServer{ctrl: ctrl}
+	mock.recorder = &MockGitBeamCommitsService_ExportCommitsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitBeamCommitsService_ExportCommitsServer) EXPECT() *MockGitBeamCommitsService_ExportCommitsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockGitBeamCommitsService_ExportCommitsServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsServer) Send(arg0 *commits.Commit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockGitBeamCommitsService_ExportCommitsServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockGitBeamCommitsService_ExportCommitsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockGitBeamCommitsService_ExportCommitsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockGitBeamCommitsService_ExportCommitsServer)(nil).SetTrailer), arg0)
 }
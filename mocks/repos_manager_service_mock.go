@@ -36,6 +36,26 @@ func (m *MockGitBeamRepositoryServiceClient) EXPECT() *MockGitBeamRepositoryServ
 	return m.recorder
 }
 
+// DeleteGitRepo mocks base method.
+func (m *MockGitBeamRepositoryServiceClient) DeleteGitRepo(ctx context.Context, in *gitRepos.GetGitRepoRequest, opts ...grpc.CallOption) (*gitRepos.Void, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGitRepo", varargs...)
+	ret0, _ := ret[0].(*gitRepos.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGitRepo indicates an expected call of DeleteGitRepo.
+func (mr *MockGitBeamRepositoryServiceClientMockRecorder) DeleteGitRepo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).DeleteGitRepo), varargs...)
+}
+
 // GetGitRepo mocks base method.
 func (m *MockGitBeamRepositoryServiceClient) GetGitRepo(ctx context.Context, in *gitRepos.GetGitRepoRequest, opts ...grpc.CallOption) (*gitRepos.Repo, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +76,26 @@ func (mr *MockGitBeamRepositoryServiceClientMockRecorder) GetGitRepo(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).GetGitRepo), varargs...)
 }
 
+// GetRepoStatsHistory mocks base method.
+func (m *MockGitBeamRepositoryServiceClient) GetRepoStatsHistory(ctx context.Context, in *gitRepos.RepoStatsHistoryRequest, opts ...grpc.CallOption) (*gitRepos.RepoStatsHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRepoStatsHistory", varargs...)
+	ret0, _ := ret[0].(*gitRepos.RepoStatsHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepoStatsHistory indicates an expected call of GetRepoStatsHistory.
+func (mr *MockGitBeamRepositoryServiceClientMockRecorder) GetRepoStatsHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoStatsHistory", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).GetRepoStatsHistory), varargs...)
+}
+
 // HealthCheck mocks base method.
 func (m *MockGitBeamRepositoryServiceClient) HealthCheck(ctx context.Context, in *gitRepos.Void, opts ...grpc.CallOption) (*gitRepos.HealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -77,7 +117,7 @@ func (mr *MockGitBeamRepositoryServiceClientMockRecorder) HealthCheck(ctx, in in
 }
 
 // ListGitRepositories mocks base method.
-func (m *MockGitBeamRepositoryServiceClient) ListGitRepositories(ctx context.Context, in *gitRepos.Void, opts ...grpc.CallOption) (*gitRepos.ListGitRepositoriesResponse, error) {
+func (m *MockGitBeamRepositoryServiceClient) ListGitRepositories(ctx context.Context, in *gitRepos.ListGitRepositoriesRequest, opts ...grpc.CallOption) (*gitRepos.ListGitRepositoriesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
@@ -96,6 +136,66 @@ func (mr *MockGitBeamRepositoryServiceClientMockRecorder) ListGitRepositories(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGitRepositories", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).ListGitRepositories), varargs...)
 }
 
+// ListRepoEvents mocks base method.
+func (m *MockGitBeamRepositoryServiceClient) ListRepoEvents(ctx context.Context, in *gitRepos.ListRepoEventsRequest, opts ...grpc.CallOption) (*gitRepos.ListRepoEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRepoEvents", varargs...)
+	ret0, _ := ret[0].(*gitRepos.ListRepoEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepoEvents indicates an expected call of ListRepoEvents.
+func (mr *MockGitBeamRepositoryServiceClientMockRecorder) ListRepoEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepoEvents", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).ListRepoEvents), varargs...)
+}
+
+// RefreshGitRepo mocks base method.
+func (m *MockGitBeamRepositoryServiceClient) RefreshGitRepo(ctx context.Context, in *gitRepos.GetGitRepoRequest, opts ...grpc.CallOption) (*gitRepos.Repo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshGitRepo", varargs...)
+	ret0, _ := ret[0].(*gitRepos.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshGitRepo indicates an expected call of RefreshGitRepo.
+func (mr *MockGitBeamRepositoryServiceClientMockRecorder) RefreshGitRepo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).RefreshGitRepo), varargs...)
+}
+
+// RegisterGitRepo mocks base method.
+func (m *MockGitBeamRepositoryServiceClient) RegisterGitRepo(ctx context.Context, in *gitRepos.RegisterGitRepoRequest, opts ...grpc.CallOption) (*gitRepos.Repo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterGitRepo", varargs...)
+	ret0, _ := ret[0].(*gitRepos.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterGitRepo indicates an expected call of RegisterGitRepo.
+func (mr *MockGitBeamRepositoryServiceClientMockRecorder) RegisterGitRepo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceClient)(nil).RegisterGitRepo), varargs...)
+}
+
 // MockGitBeamRepositoryServiceServer is a mock of GitBeamRepositoryServiceServer interface.
 type MockGitBeamRepositoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -119,6 +219,21 @@ func (m *MockGitBeamRepositoryServiceServer) EXPECT() *MockGitBeamRepositoryServ
 	return m.recorder
 }
 
+// DeleteGitRepo mocks base method.
+func (m *MockGitBeamRepositoryServiceServer) DeleteGitRepo(arg0 context.Context, arg1 *gitRepos.GetGitRepoRequest) (*gitRepos.Void, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGitRepo", arg0, arg1)
+	ret0, _ := ret[0].(*gitRepos.Void)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGitRepo indicates an expected call of DeleteGitRepo.
+func (mr *MockGitBeamRepositoryServiceServerMockRecorder) DeleteGitRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).DeleteGitRepo), arg0, arg1)
+}
+
 // GetGitRepo mocks base method.
 func (m *MockGitBeamRepositoryServiceServer) GetGitRepo(arg0 context.Context, arg1 *gitRepos.GetGitRepoRequest) (*gitRepos.Repo, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +249,21 @@ func (mr *MockGitBeamRepositoryServiceServerMockRecorder) GetGitRepo(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).GetGitRepo), arg0, arg1)
 }
 
+// GetRepoStatsHistory mocks base method.
+func (m *MockGitBeamRepositoryServiceServer) GetRepoStatsHistory(arg0 context.Context, arg1 *gitRepos.RepoStatsHistoryRequest) (*gitRepos.RepoStatsHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepoStatsHistory", arg0, arg1)
+	ret0, _ := ret[0].(*gitRepos.RepoStatsHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepoStatsHistory indicates an expected call of GetRepoStatsHistory.
+func (mr *MockGitBeamRepositoryServiceServerMockRecorder) GetRepoStatsHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoStatsHistory", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).GetRepoStatsHistory), arg0, arg1)
+}
+
 // HealthCheck mocks base method.
 func (m *MockGitBeamRepositoryServiceServer) HealthCheck(arg0 context.Context, arg1 *gitRepos.Void) (*gitRepos.HealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -150,7 +280,7 @@ func (mr *MockGitBeamRepositoryServiceServerMockRecorder) HealthCheck(arg0, arg1
 }
 
 // ListGitRepositories mocks base method.
-func (m *MockGitBeamRepositoryServiceServer) ListGitRepositories(arg0 context.Context, arg1 *gitRepos.Void) (*gitRepos.ListGitRepositoriesResponse, error) {
+func (m *MockGitBeamRepositoryServiceServer) ListGitRepositories(arg0 context.Context, arg1 *gitRepos.ListGitRepositoriesRequest) (*gitRepos.ListGitRepositoriesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListGitRepositories", arg0, arg1)
 	ret0, _ := ret[0].(*gitRepos.ListGitRepositoriesResponse)
@@ -163,3 +293,48 @@ func (mr *MockGitBeamRepositoryServiceServerMockRecorder) ListGitRepositories(ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGitRepositories", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).ListGitRepositories), arg0, arg1)
 }
+
+// ListRepoEvents mocks base method.
+func (m *MockGitBeamRepositoryServiceServer) ListRepoEvents(arg0 context.Context, arg1 *gitRepos.ListRepoEventsRequest) (*gitRepos.ListRepoEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepoEvents", arg0, arg1)
+	ret0, _ := ret[0].(*gitRepos.ListRepoEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepoEvents indicates an expected call of ListRepoEvents.
+func (mr *MockGitBeamRepositoryServiceServerMockRecorder) ListRepoEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepoEvents", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).ListRepoEvents), arg0, arg1)
+}
+
+// RefreshGitRepo mocks base method.
+func (m *MockGitBeamRepositoryServiceServer) RefreshGitRepo(arg0 context.Context, arg1 *gitRepos.GetGitRepoRequest) (*gitRepos.Repo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshGitRepo", arg0, arg1)
+	ret0, _ := ret[0].(*gitRepos.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshGitRepo indicates an expected call of RefreshGitRepo.
+func (mr *MockGitBeamRepositoryServiceServerMockRecorder) RefreshGitRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).RefreshGitRepo), arg0, arg1)
+}
+
+// RegisterGitRepo mocks base method.
+func (m *MockGitBeamRepositoryServiceServer) RegisterGitRepo(arg0 context.Context, arg1 *gitRepos.RegisterGitRepoRequest) (*gitRepos.Repo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterGitRepo", arg0, arg1)
+	ret0, _ := ret[0].(*gitRepos.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterGitRepo indicates an expected call of RegisterGitRepo.
+func (mr *MockGitBeamRepositoryServiceServerMockRecorder) RegisterGitRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterGitRepo", reflect.TypeOf((*MockGitBeamRepositoryServiceServer)(nil).RegisterGitRepo), arg0, arg1)
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -14,15 +15,29 @@ func (ct *Date) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ct.Format(time.DateOnly))
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
+// DateError is the error of JSON values that aren't dates.
+type DateError struct {
+	Value string
+	Err   error
+}
+
+func (e *DateError) Error() string {
+	return fmt.Sprintf("invalid date %s: %v", e.Value, e.Err)
+}
+
+func (e *DateError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, values that aren't dates fail with a *DateError.
 func (ct *Date) UnmarshalJSON(data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
-		return err
+		return &DateError{Value: string(data), Err: err}
 	}
 	t, err := time.Parse(time.DateOnly, str)
 	if err != nil {
-		return err
+		return &DateError{Value: string(data), Err: err}
 	}
 	ct.Time = t
 	return nil
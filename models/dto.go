@@ -1,7 +1,10 @@
 package models
 
 import (
+	"errors"
 	validation "github.com/go-ozzo/ozzo-validation"
+	"net/url"
+	"strings"
 )
 
 type Result struct {
@@ -15,14 +18,62 @@ type OwnerAndRepoName struct {
 	RepoName  string `json:"repoName" schema:"repoName"`
 }
 
+// MirrorRepoCommitsRequest is the payload of POST /commits/start-monitoring.
 type MirrorRepoCommitsRequest struct {
 	OwnerAndRepoName `json:",inline"`
-	FromDate         *Date `json:"fromDate,omitempty"`
-	ToDate           *Date `json:"toDate,omitempty"`
+	FromDate         *Date    `json:"fromDate,omitempty"`
+	ToDate           *Date    `json:"toDate,omitempty"`
+	DurationInHours  *int64   `json:"durationInHours,omitempty"`
+	Branches         []string `json:"branches,omitempty"`
+	EnrichDiffStats  bool     `json:"enrichDiffStats,omitempty"`
+	Webhooks         []string `json:"webhooks,omitempty"`
+}
+
+// MonitorOwnerRepositoriesRequest is the payload of POST /orgs/{ownerName}/start-monitoring.
+type MonitorOwnerRepositoriesRequest struct {
+	FromDate        *Date    `json:"fromDate,omitempty"`
+	ToDate          *Date    `json:"toDate,omitempty"`
+	DurationInHours *int64   `json:"durationInHours,omitempty"`
+	Branches        []string `json:"branches,omitempty"`
+	EnrichDiffStats bool     `json:"enrichDiffStats,omitempty"`
+	IncludeForks    bool     `json:"includeForks,omitempty"`
+	IncludeArchived bool     `json:"includeArchived,omitempty"`
+}
+
+// RegisterRepoRequest registers a repo either by its owner and repo name, or by its GitHub URL.
+type RegisterRepoRequest struct {
+	OwnerAndRepoName `json:",inline"`
+	URL              string `json:"url,omitempty"`
+}
+
+var ErrInvalidRepoURL = errors.New("must be a github repository url, e.g. https://github.com/chromium/chromium")
+
+// Resolve fills in the owner and repo name from the URL when it is set, and validates them.
+func (s *RegisterRepoRequest) Resolve() error {
+	if s.URL != "" {
+		rawURL := s.URL
+		if !strings.Contains(rawURL, "://") {
+			rawURL = "https://" + rawURL
+		}
+
+		u, err := url.Parse(rawURL)
+		if err != nil || !strings.EqualFold(strings.TrimPrefix(u.Host, "www."), "github.com") {
+			return validation.Errors{"url": ErrInvalidRepoURL}
+		}
+
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return validation.Errors{"url": ErrInvalidRepoURL}
+		}
+
+		s.OwnerName, s.RepoName = parts[0], strings.TrimSuffix(parts[1], ".git")
+	}
+
+	return s.OwnerAndRepoName.Validate()
 }
 
 func (s OwnerAndRepoName) Validate() error {
 	return validation.ValidateStruct(&s,
-		validation.Field(&s.OwnerName, validation.Required),
-		validation.Field(&s.RepoName, validation.Required))
+		validation.Field(&s.OwnerName, validation.Required, notBlank),
+		validation.Field(&s.RepoName, validation.Required, notBlank))
 }
@@ -5,22 +5,256 @@ import (
 )
 
 type Commit struct {
-	Date            time.Time `json:"date"`
-	Message         string    `json:"message"`
-	Author          string    `json:"author"`
-	RepoName        string    `json:"repoName"`
-	OwnerName       string    `json:"ownerName"`
-	URL             string    `json:"url"`
-	SHA             string    `json:"sha"`
-	ParentCommitIDs []string  `json:"parentCommitIDs"`
+	Date            time.Time           `json:"date"`
+	Message         string              `json:"message"`
+	Author          string              `json:"author"`
+	RepoName        string              `json:"repoName"`
+	OwnerName       string              `json:"ownerName"`
+	URL             string              `json:"url"`
+	SHA             string              `json:"sha"`
+	ParentCommitIDs []string            `json:"parentCommitIDs"`
+	Branches        []string            `json:"branches"`
+	Stats           *CommitStats        `json:"stats,omitempty"`
+	Files           []CommitFile        `json:"files,omitempty"`
+	Trailers        []Trailer           `json:"trailers,omitempty"`
+	AuthorEmail     string              `json:"authorEmail"`
+	AuthorLogin     string              `json:"authorLogin"`
+	Conventional    *ConventionalCommit `json:"conventional,omitempty"`
+	Issues          []IssueReference    `json:"issues,omitempty"`
+	Meta            *CommitMeta         `json:"meta,omitempty"`
 }
 
-type CommitFilters struct {
+// CommitMeta tells who wrote a commit from who committed it, they differ e.g. on cherry-picks and rebases,
+// and whether GitHub verified its signature.
+type CommitMeta struct {
+	Author       CommitIdentity     `json:"author"`
+	Committer    CommitIdentity     `json:"committer"`
+	Verification CommitVerification `json:"verification"`
+}
+
+type CommitIdentity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Login string `json:"login"`
+	Date  string `json:"date"`
+}
+
+type CommitVerification struct {
+	Verified  bool   `json:"verified"`
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+	Payload   string `json:"payload"`
+}
+
+type IssueReference struct {
+	Key     string `json:"key"`
+	URL     string `json:"url"`
+	Pattern string `json:"pattern"`
+}
+
+// IssueKeyPlaceholder is replaced with the issue key in the URL template of an issue pattern.
+const IssueKeyPlaceholder = "{key}"
+
+type IssuePattern struct {
+	Name        string `json:"name"`
+	Pattern     string `json:"pattern"`
+	URLTemplate string `json:"urlTemplate"`
+}
+
+type IssuePatternsConfig struct {
+	OwnerAndRepoName `json:",inline"`
+	Patterns         []IssuePattern `json:"patterns"`
+}
+
+type IssueCommitsFilters struct {
 	OwnerAndRepoName `json:",inline" schema:",inline"`
 	Limit            int64 `json:"limit" schema:"limit,omitempty"`
 	Page             int64 `json:"page" schema:"page,omitempty"`
-	FromDate         *Date `json:"fromDate" schema:"fromDate,omitempty"`
-	ToDate           *Date `json:"toDate" schema:"toDate,omitempty"`
+}
+
+type ConventionalCommit struct {
+	Type        string `json:"type"`
+	Scope       string `json:"scope"`
+	Description string `json:"description"`
+	Breaking    bool   `json:"breaking"`
+}
+
+type CommitTypeCount struct {
+	Type          string `json:"type"`
+	CommitsCount  int64  `json:"commitsCount"`
+	BreakingCount int64  `json:"breakingCount"`
+}
+
+type Trailer struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type CommitStats struct {
+	Additions int64 `json:"additions"`
+	Deletions int64 `json:"deletions"`
+	Total     int64 `json:"total"`
+}
+
+type CommitFile struct {
+	Filename         string `json:"filename"`
+	Status           string `json:"status"`
+	PreviousFilename string `json:"previousFilename,omitempty"`
+	Additions        int64  `json:"additions"`
+	Deletions        int64  `json:"deletions"`
+	Changes          int64  `json:"changes"`
+}
+
+type CommitFilters struct {
+	OwnerAndRepoName `json:",inline" schema:",inline"`
+	Limit            int64             `json:"limit" schema:"limit,omitempty"`
+	Page             int64             `json:"page" schema:"page,omitempty"`
+	FromDate         *Date             `json:"fromDate" schema:"fromDate,omitempty"`
+	ToDate           *Date             `json:"toDate" schema:"toDate,omitempty"`
+	Branch           string            `json:"branch" schema:"branch,omitempty"`
+	Path             string            `json:"path" schema:"path,omitempty"`
+	Trailers         map[string]string `json:"trailers" schema:"-"`
+	IncludeBots      bool              `json:"includeBots" schema:"includeBots,omitempty"`
+	Type             string            `json:"type" schema:"type,omitempty"`
+	Scope            string            `json:"scope" schema:"scope,omitempty"`
+	Breaking         *bool             `json:"breaking" schema:"breaking,omitempty"`
+	// IncludeChurn only applies to the top authors leaderboards.
+	IncludeChurn bool `json:"includeChurn" schema:"includeChurn,omitempty"`
+}
+
+// TopAuthorTrendsFilters split the top authors leaderboard into weeks or months, or rank the last
+// windowDays days against the ones before them.
+type TopAuthorTrendsFilters struct {
+	CommitFilters `json:",inline" schema:",inline"`
+	GroupBy       string `json:"groupBy" schema:"groupBy,omitempty"`
+	WindowDays    int64  `json:"windowDays" schema:"windowDays,omitempty"`
+}
+
+// Trends tells whether the leaderboard is split or ranked, rather than the flat list.
+func (f TopAuthorTrendsFilters) Trends() bool {
+	return f.GroupBy != "" || f.WindowDays != 0
+}
+
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+
+	BreakdownAuthor = "author"
+	BreakdownBranch = "branch"
+)
+
+type CommitActivityFilters struct {
+	CommitFilters `json:",inline" schema:",inline"`
+	Interval      string `json:"interval" schema:"interval,omitempty"`
+	Breakdown     string `json:"breakdown" schema:"breakdown,omitempty"`
+}
+
+type ActivityBucket struct {
+	BucketStart  string           `json:"bucketStart"`
+	Breakdown    map[string]int64 `json:"breakdown,omitempty"`
+	CommitsCount int64            `json:"commitsCount"`
+}
+
+type PunchCardEntry struct {
+	Weekday      int   `json:"weekday"`
+	Hour         int   `json:"hour"`
+	CommitsCount int64 `json:"commitsCount"`
+}
+
+type CommitActivity struct {
+	Buckets   []ActivityBucket `json:"buckets"`
+	PunchCard []PunchCardEntry `json:"punchCard"`
+}
+
+const (
+	ExportFormatCSV     = "csv"
+	ExportFormatNDJSON  = "ndjson"
+	ExportFormatParquet = "parquet"
+)
+
+type CommitExportFilters struct {
+	CommitFilters `json:",inline" schema:",inline"`
+	Format        string `json:"format" schema:"format,omitempty"`
+}
+
+type CommitGraphFilters struct {
+	Depth       int64 `json:"depth" schema:"depth,omitempty"`
+	Limit       int64 `json:"limit" schema:"limit,omitempty"`
+	FirstParent bool  `json:"firstParent" schema:"firstParent,omitempty"`
+}
+
+type CommitPair struct {
+	A string `json:"a" schema:"a"`
+	B string `json:"b" schema:"b"`
+}
+
+type AncestorPair struct {
+	Ancestor   string `json:"ancestor" schema:"ancestor"`
+	Descendant string `json:"descendant" schema:"descendant"`
+}
+
+type CommitSearchFilters struct {
+	CommitFilters `json:",inline" schema:",inline"`
+	Query         string `json:"q" schema:"q"`
+	Author        string `json:"author" schema:"author,omitempty"`
+}
+
+type CommitSearchResult struct {
+	Commit  Commit  `json:"commit"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
+
+type Pagination struct {
+	Limit int64 `json:"limit" schema:"limit,omitempty"`
+	Page  int64 `json:"page" schema:"page,omitempty"`
+}
+
+type Tag struct {
+	Name      string `json:"name"`
+	OwnerName string `json:"ownerName"`
+	RepoName  string `json:"repoName"`
+	CommitSHA string `json:"commitSha"`
+	Date      string `json:"date"`
+	Message   string `json:"message"`
+}
+
+type Release struct {
+	ID          int64  `json:"id"`
+	OwnerName   string `json:"ownerName"`
+	RepoName    string `json:"repoName"`
+	TagName     string `json:"tagName"`
+	Name        string `json:"name"`
+	Body        string `json:"body"`
+	CommitSHA   string `json:"commitSha"`
+	Author      string `json:"author"`
+	URL         string `json:"url"`
+	Draft       bool   `json:"draft"`
+	Prerelease  bool   `json:"prerelease"`
+	CreatedAt   string `json:"createdAt"`
+	PublishedAt string `json:"publishedAt"`
+}
+
+type TagRange struct {
+	FromTag string `json:"fromTag" schema:"fromTag"`
+	ToTag   string `json:"toTag" schema:"toTag"`
+}
+
+const (
+	ChangelogFormatMarkdown = "markdown"
+	ChangelogFormatJSON     = "json"
+
+	ChangelogGroupByType = "type"
+	// ChangelogGroupByTrailerPrefix groups commits by the values of a trailer, e.g. trailer.Bug.
+	ChangelogGroupByTrailerPrefix = "trailer."
+)
+
+type ChangelogFilters struct {
+	From    string `json:"from" schema:"from"`
+	To      string `json:"to" schema:"to"`
+	Format  string `json:"format" schema:"format,omitempty"`
+	GroupBy string `json:"groupBy" schema:"groupBy,omitempty"`
 }
 
 type Repo struct {
@@ -30,15 +264,163 @@ type Repo struct {
 	Owner         string `json:"owner"`
 	Description   string `json:"description"`
 	URL           string `json:"url"`
-	Languages     string `json:"language"`
-	Meta          string `json:"meta"`
+	Language      string `json:"language"`
 	ForkCount     int64  `json:"forkCounts"`
 	StarCount     int64  `json:"starCounts"`
 	OpenIssues    int64  `json:"openIssues"`
 	WatchersCount int64  `json:"watchersCount"`
+	// LastRefreshedAt is when the counts above were last refreshed from GitHub.
+	LastRefreshedAt string `json:"lastRefreshedAt"`
+	Monitored       bool   `json:"monitored"`
+	// ProviderID is the GitHub ID of the repo, which stays the same across renames and transfers.
+	ProviderID int64       `json:"providerId"`
+	Status     string      `json:"status"`
+	Aliases    []RepoAlias `json:"aliases"`
+	Meta       *RepoMeta   `json:"meta,omitempty"`
+}
+
+type RepoMeta struct {
+	// Languages holds the bytes of code per language, Language is the main one.
+	Languages     map[string]int64 `json:"languages"`
+	Topics        []string         `json:"topics"`
+	License       string           `json:"license"`
+	DefaultBranch string           `json:"defaultBranch"`
+	Archived      bool             `json:"archived"`
+	Visibility    string           `json:"visibility"`
+}
+
+const (
+	RepoStatusActive   = "active"
+	RepoStatusArchived = "archived"
+	RepoStatusDeleted  = "deleted"
+
+	RepoEventRenamed     = "renamed"
+	RepoEventTransferred = "transferred"
+	RepoEventArchived    = "archived"
+	RepoEventDeleted     = "deleted"
+)
+
+// RepoAlias is an owner and repo name a repo went by before it was renamed or transferred.
+type RepoAlias struct {
+	OwnerName string `json:"ownerName"`
+	RepoName  string `json:"repoName"`
+	Until     string `json:"until"`
+}
+
+type RepoEvent struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	ProviderID    int64  `json:"providerId"`
+	FromOwnerName string `json:"fromOwnerName"`
+	FromRepoName  string `json:"fromRepoName"`
+	ToOwnerName   string `json:"toOwnerName"`
+	ToRepoName    string `json:"toRepoName"`
+	OccurredAt    string `json:"occurredAt"`
+}
+
+const (
+	RepoSortByStars   = "stars"
+	RepoSortByUpdated = "updated"
+	RepoSortByName    = "name"
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+type RepoFilters struct {
+	Pagination `json:",inline" schema:",inline"`
+	OwnerName  string `json:"ownerName" schema:"ownerName,omitempty"`
+	Language   string `json:"language" schema:"language,omitempty"`
+	Name       string `json:"name" schema:"name,omitempty"`
+	MinStars   int64  `json:"minStars" schema:"minStars,omitempty"`
+	Monitored  *bool  `json:"monitored" schema:"monitored,omitempty"`
+	SortBy     string `json:"sortBy" schema:"sortBy,omitempty"`
+	Order      string `json:"order" schema:"order,omitempty"`
+}
+
+// MaxBulkConcurrency caps how many repos a bulk monitoring request starts or stops at once.
+const MaxBulkConcurrency = 32
+
+type BulkMonitoringFilters struct {
+	Sync        bool `json:"sync" schema:"sync,omitempty"`
+	Concurrency int  `json:"concurrency" schema:"concurrency,omitempty"`
+}
+
+type DeleteRepoFilters struct {
+	PurgeCommits bool `json:"purgeCommits" schema:"purgeCommits,omitempty"`
+}
+
+type RepoStatsHistoryFilters struct {
+	FromDate *Date  `json:"fromDate" schema:"fromDate,omitempty"`
+	ToDate   *Date  `json:"toDate" schema:"toDate,omitempty"`
+	Interval string `json:"interval" schema:"interval,omitempty"`
+}
+
+// RepoStatsSnapshot is what the repo counts were when the repo manager last refreshed them at RecordedAt.
+type RepoStatsSnapshot struct {
+	RecordedAt    string `json:"recordedAt"`
+	StarCount     int64  `json:"starCounts"`
+	ForkCount     int64  `json:"forkCounts"`
+	OpenIssues    int64  `json:"openIssues"`
+	WatchersCount int64  `json:"watchersCount"`
 }
 
+const (
+	GroupByWeek  = "week"
+	GroupByMonth = "month"
+)
+
 type TopCommitAuthor struct {
-	Author      string `json:"author"`
-	CommitCount int    `json:"commitCount"`
+	Author               string `json:"author"`
+	Email                string `json:"email"`
+	Login                string `json:"login"`
+	FirstCommitDate      string `json:"firstCommitDate"`
+	LastCommitDate       string `json:"lastCommitDate"`
+	CommitsCount         int    `json:"commitsCount"`
+	PreviousCommitsCount int    `json:"previousCommitsCount"`
+	Rank                 int    `json:"rank"`
+	PreviousRank         int    `json:"previousRank"`
+	RankDelta            int    `json:"rankDelta"`
+	Additions            int64  `json:"additions"`
+	Deletions            int64  `json:"deletions"`
+}
+
+type TopCommitAuthorBucket struct {
+	BucketStart string            `json:"bucketStart"`
+	BucketEnd   string            `json:"bucketEnd"`
+	Data        []TopCommitAuthor `json:"data"`
+}
+
+type DateWindow struct {
+	FromDate string `json:"fromDate"`
+	ToDate   string `json:"toDate"`
+}
+
+// TopCommitAuthorTrends is the top authors leaderboard of GET /commits/top-authors when it's split
+// into buckets with groupBy, or ranked against the previous window with windowDays.
+type TopCommitAuthorTrends struct {
+	Data           []TopCommitAuthor       `json:"data"`
+	Buckets        []TopCommitAuthorBucket `json:"buckets"`
+	CurrentWindow  *DateWindow             `json:"currentWindow"`
+	PreviousWindow *DateWindow             `json:"previousWindow"`
+}
+
+type AuthorAlias struct {
+	Name   string   `json:"name"`
+	Email  string   `json:"email"`
+	Login  string   `json:"login"`
+	Names  []string `json:"names"`
+	Emails []string `json:"emails"`
+	Logins []string `json:"logins"`
+}
+
+type AuthorAliasesConfig struct {
+	OwnerAndRepoName `json:",inline"`
+	Aliases          []AuthorAlias `json:"aliases"`
+	BotPatterns      []string      `json:"botPatterns"`
+}
+
+type TopReviewer struct {
+	Reviewer     string `json:"reviewer"`
+	ReviewsCount int    `json:"reviewsCount"`
 }
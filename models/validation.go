@@ -0,0 +1,246 @@
+package models
+
+import (
+	"errors"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MaxLimit caps how many results a page holds.
+const MaxLimit = 100
+
+// ErrInvalidDate is the field error of dates that don't parse.
+var ErrInvalidDate = errors.New("must be a valid date, e.g. 2024-07-01")
+
+var (
+	errDateRange  = errors.New("must not be after toDate")
+	errBlank      = errors.New("cannot be blank")
+	errWebhookURL = errors.New("must be an http or https url")
+	errMinHours   = errors.New("must be no less than 1")
+	errGroupBy    = errors.New("must be type or trailer.<Key>")
+	errCaptureKey = errors.New("needs a capture group for the issue key")
+	errURLKey     = errors.New("must contain " + IssueKeyPlaceholder)
+)
+
+// limitRules and pageRules check the limit and page of paginated requests, zero values picking the default.
+func limitRules() []validation.Rule {
+	return []validation.Rule{validation.Min(int64(1)), validation.Max(int64(MaxLimit))}
+}
+
+func pageRules() []validation.Rule {
+	return []validation.Rule{validation.Min(int64(1))}
+}
+
+// notAfter checks a from date isn't after the to date, when both are set.
+func notAfter(to *Date) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		from, _ := value.(*Date)
+		if from != nil && to != nil && from.After(to.Time) {
+			return errDateRange
+		}
+
+		return nil
+	})
+}
+
+// notBlank is validation.Required for strings made of spaces.
+var notBlank = validation.By(func(value interface{}) error {
+	if s, _ := value.(string); s != "" && strings.TrimSpace(s) == "" {
+		return errBlank
+	}
+
+	return nil
+})
+
+// webhookURL checks a webhook is an absolute http or https URL.
+var webhookURL = validation.By(func(value interface{}) error {
+	u, err := url.Parse(value.(string))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errWebhookURL
+	}
+
+	return nil
+})
+
+// positiveHours checks an optional duration in hours, unlike validation.Min an explicit 0 fails.
+var positiveHours = validation.By(func(value interface{}) error {
+	if hours, _ := value.(*int64); hours != nil && *hours < 1 {
+		return errMinHours
+	}
+
+	return nil
+})
+
+func (p Pagination) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Limit, limitRules()...),
+		validation.Field(&p.Page, pageRules()...))
+}
+
+// ValidateScope validates the scope of author aliases or issue patterns, the repo name being optional.
+func (s OwnerAndRepoName) ValidateScope() error {
+	return validation.ValidateStruct(&s,
+		validation.Field(&s.OwnerName, validation.Required, notBlank))
+}
+
+func (f CommitFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.OwnerName, validation.Required, notBlank),
+		validation.Field(&f.Limit, limitRules()...),
+		validation.Field(&f.Page, pageRules()...),
+		validation.Field(&f.FromDate, notAfter(f.ToDate)))
+}
+
+func (f TopAuthorTrendsFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.CommitFilters),
+		validation.Field(&f.GroupBy, validation.In(GroupByWeek, GroupByMonth).Error("must be one of week or month")),
+		validation.Field(&f.WindowDays, validation.Min(int64(1))))
+}
+
+// Validate leaves the limit alone, exports streaming every commit matching the filters.
+func (f CommitExportFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.OwnerName, validation.Required, notBlank),
+		validation.Field(&f.FromDate, notAfter(f.ToDate)),
+		validation.Field(&f.Format, validation.In(ExportFormatCSV, ExportFormatNDJSON, ExportFormatParquet).Error("must be one of csv, ndjson or parquet")))
+}
+
+func (f CommitActivityFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.CommitFilters),
+		validation.Field(&f.Interval, validation.In(IntervalDay, IntervalWeek, IntervalMonth).Error("must be one of day, week or month")),
+		validation.Field(&f.Breakdown, validation.In(BreakdownAuthor, BreakdownBranch).Error("must be one of author or branch")))
+}
+
+// Validate leaves ownerName optional, searches running across every owner unless narrowed down.
+func (f CommitSearchFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.Limit, limitRules()...),
+		validation.Field(&f.Page, pageRules()...),
+		validation.Field(&f.FromDate, notAfter(f.ToDate)),
+		validation.Field(&f.Query, validation.Required, notBlank))
+}
+
+func (f CommitGraphFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.Depth, validation.Min(int64(1))),
+		validation.Field(&f.Limit, limitRules()...))
+}
+
+func (p CommitPair) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.A, validation.Required),
+		validation.Field(&p.B, validation.Required))
+}
+
+func (p AncestorPair) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Ancestor, validation.Required),
+		validation.Field(&p.Descendant, validation.Required))
+}
+
+func (f IssueCommitsFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.Limit, limitRules()...),
+		validation.Field(&f.Page, pageRules()...))
+}
+
+func (r TagRange) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.FromTag, validation.Required),
+		validation.Field(&r.ToTag, validation.Required))
+}
+
+func (f ChangelogFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.From, validation.Required),
+		validation.Field(&f.To, validation.Required),
+		validation.Field(&f.Format, validation.In(ChangelogFormatMarkdown, ChangelogFormatJSON).Error("must be one of markdown or json")),
+		validation.Field(&f.GroupBy, validation.By(func(value interface{}) error {
+			groupBy := value.(string)
+			if trailerKey, byTrailer := strings.CutPrefix(groupBy, ChangelogGroupByTrailerPrefix); byTrailer && strings.TrimSpace(trailerKey) != "" {
+				return nil
+			}
+
+			if groupBy == "" || groupBy == ChangelogGroupByType {
+				return nil
+			}
+
+			return errGroupBy
+		})))
+}
+
+func (f RepoFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.Pagination),
+		validation.Field(&f.MinStars, validation.Min(int64(0))),
+		validation.Field(&f.SortBy, validation.In(RepoSortByStars, RepoSortByUpdated, RepoSortByName).Error("must be one of stars, updated or name")),
+		validation.Field(&f.Order, validation.In(OrderAsc, OrderDesc).Error("must be one of asc or desc")))
+}
+
+func (f RepoStatsHistoryFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.FromDate, notAfter(f.ToDate)),
+		validation.Field(&f.Interval, validation.In(IntervalDay, IntervalWeek, IntervalMonth).Error("must be one of day, week or month")))
+}
+
+func (s MirrorRepoCommitsRequest) Validate() error {
+	return validation.ValidateStruct(&s,
+		validation.Field(&s.OwnerAndRepoName),
+		validation.Field(&s.FromDate, notAfter(s.ToDate)),
+		validation.Field(&s.DurationInHours, positiveHours),
+		validation.Field(&s.Branches, validation.Each(validation.Required, notBlank)),
+		validation.Field(&s.Webhooks, validation.Each(validation.Required, webhookURL)))
+}
+
+func (s MonitorOwnerRepositoriesRequest) Validate() error {
+	return validation.ValidateStruct(&s,
+		validation.Field(&s.FromDate, notAfter(s.ToDate)),
+		validation.Field(&s.DurationInHours, positiveHours),
+		validation.Field(&s.Branches, validation.Each(validation.Required, notBlank)))
+}
+
+func (f BulkMonitoringFilters) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.Concurrency, validation.Min(1), validation.Max(MaxBulkConcurrency)))
+}
+
+func (c AuthorAliasesConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OwnerName, validation.Required, notBlank))
+}
+
+func (c IssuePatternsConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.OwnerName, validation.Required, notBlank),
+		validation.Field(&c.Patterns))
+}
+
+// Validate checks the pattern compiles with a capture group for the issue key, so a typo doesn't
+// silently stop issues from being linked.
+func (p IssuePattern) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Name, validation.Required),
+		validation.Field(&p.Pattern, validation.Required, validation.By(func(value interface{}) error {
+			expression, err := regexp.Compile(value.(string))
+			if err != nil {
+				return err
+			}
+
+			if expression.NumSubexp() < 1 {
+				return errCaptureKey
+			}
+
+			return nil
+		})),
+		validation.Field(&p.URLTemplate, validation.By(func(value interface{}) error {
+			if template := value.(string); template != "" && !strings.Contains(template, IssueKeyPlaceholder) {
+				return errURLKey
+			}
+
+			return nil
+		})))
+}
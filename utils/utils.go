@@ -3,7 +3,9 @@ package utils
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"gitbeam/models"
+	validation "github.com/go-ozzo/ozzo-validation"
 	"net/http"
 )
 
@@ -17,6 +19,24 @@ func WriteHTTPError(w http.ResponseWriter, statusCode int, err error) {
 	})
 }
 
+// WriteHTTPValidationError answers field errors with 422 and the errors of each field as data, other
+// errors being bad requests.
+func WriteHTTPValidationError(w http.ResponseWriter, err error) {
+	var fieldErrors validation.Errors
+	if !errors.As(err, &fieldErrors) {
+		WriteHTTPError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	_ = json.NewEncoder(w).Encode(&models.Result{
+		Success: false,
+		Message: fieldErrors.Error(),
+		Data:    fieldErrors,
+	})
+}
+
 func WriteHTTPSuccess(w http.ResponseWriter, message string, data any) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
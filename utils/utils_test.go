@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"gitbeam/models"
+	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
@@ -31,6 +32,26 @@ func TestWriteHTTPError(t *testing.T) {
 	assert.Nil(t, result.Data)
 }
 
+func TestWriteHTTPValidationError(t *testing.T) {
+	rr := httptest.NewRecorder()
+	WriteHTTPValidationError(rr, validation.Errors{"ownerName": errors.New("cannot be blank")})
+
+	// Field errors are unprocessable, with the error of each field as data.
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+
+	var result models.Result
+	_ = json.NewDecoder(rr.Body).Decode(&result)
+
+	assert.False(t, result.Success)
+	assert.Equal(t, "ownerName: cannot be blank.", result.Message)
+	assert.Equal(t, map[string]any{"ownerName": "cannot be blank"}, result.Data)
+
+	// Other errors are bad requests.
+	rr = httptest.NewRecorder()
+	WriteHTTPValidationError(rr, errors.New("unexpected EOF"))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
 func TestWriteHTTPSuccess(t *testing.T) {
 	// Create a ResponseRecorder to record the response.
 	rr := httptest.NewRecorder()